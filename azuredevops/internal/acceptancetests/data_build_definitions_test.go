@@ -0,0 +1,71 @@
+//go:build (all || data_sources || data_build_definitions) && (!exclude_data_sources || !exclude_data_build_definitions)
+// +build all data_sources data_build_definitions
+// +build !exclude_data_sources !exclude_data_build_definitions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccBuildDefinitions_DataSource_RecursivePath(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_build_definitions" "build" {
+  project_id = azuredevops_project.project.id
+  path       = "\\some"
+  recursive  = true
+  name       = azuredevops_build_definition.build.name
+}`, testutils.HclBuildDefinitionResourceGitHub(name, name, "\\some\\path"))
+
+	tfNode := "data.azuredevops_build_definitions.build"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "definitions.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "definitions.0.name", name),
+					resource.TestCheckResourceAttr(tfNode, "definitions.0.path", "\\some\\path"),
+					resource.TestCheckResourceAttrSet(tfNode, "definitions.0.yml_path"),
+					resource.TestCheckResourceAttr(tfNode, "definitions.0.repository.0.type", "GitHub"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBuildDefinitions_DataSource_NonRecursivePathExcludesSubFolders(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_build_definitions" "build" {
+  project_id = azuredevops_project.project.id
+  path       = "\\some"
+  name       = azuredevops_build_definition.build.name
+}`, testutils.HclBuildDefinitionResourceGitHub(name, name, "\\some\\path"))
+
+	tfNode := "data.azuredevops_build_definitions.build"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "definitions.#", "0"),
+				),
+			},
+		},
+	})
+}
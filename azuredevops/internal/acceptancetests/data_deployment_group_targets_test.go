@@ -0,0 +1,43 @@
+//go:build (all || data_sources || data_deployment_group_targets) && (!exclude_data_sources || !exclude_data_deployment_group_targets)
+// +build all data_sources data_deployment_group_targets
+// +build !exclude_data_sources !exclude_data_deployment_group_targets
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Verifies that the targets of a new deployment group can be read. Registering deployment
+// agents requires a machine, so the deployment group does not contain any target.
+func TestAccDeploymentGroupTargets_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	deploymentGroupName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_deployment_group_targets" "targets" {
+  project_id          = azuredevops_project.project.id
+  deployment_group_id = azuredevops_deployment_group.deployment_group.id
+  tags                = ["web"]
+}`, testutils.HclDeploymentGroupResource(projectName, deploymentGroupName, "web servers"))
+
+	tfNode := "data.azuredevops_deployment_group_targets.targets"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "targets.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+//go:build (all || data_sources || data_deployment_group) && (!exclude_data_sources || !exclude_data_deployment_group)
+// +build all data_sources data_deployment_group
+// +build !exclude_data_sources !exclude_data_deployment_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDeploymentGroup_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	deploymentGroupName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_deployment_group" "by_name" {
+  project_id = azuredevops_project.project.id
+  name       = azuredevops_deployment_group.deployment_group.name
+}
+
+data "azuredevops_deployment_group" "by_id" {
+  project_id          = azuredevops_project.project.id
+  deployment_group_id = azuredevops_deployment_group.deployment_group.id
+}`, testutils.HclDeploymentGroupResource(projectName, deploymentGroupName, "web servers"))
+
+	tfNode := "data.azuredevops_deployment_group.by_name"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_deployment_group.deployment_group", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "pool_id", "azuredevops_deployment_group.deployment_group", "pool_id"),
+					resource.TestCheckResourceAttr(tfNode, "description", "web servers"),
+					resource.TestCheckResourceAttr(tfNode, "machine_count", "0"),
+					resource.TestCheckResourceAttr("data.azuredevops_deployment_group.by_id", "name", deploymentGroupName),
+				),
+			},
+		},
+	})
+}
@@ -55,6 +55,33 @@ func TestAccTfsGitRepositories_DataSource_all(t *testing.T) {
 	})
 }
 
+func TestAccTfsGitRepositories_DataSource_Statistics(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_git_repositories.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testutils.PreCheck(t, nil) },
+		Providers:                 testutils.GetProviders(),
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: hckGitRepositoriesDatSourceStatistics(projectName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.0.name", repoName),
+					resource.TestCheckResourceAttrSet(tfNode, "repositories.0.default_branch_commit_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "repositories.0.last_push_date"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.0.branches.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.0.branches.0.name", "master"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.0.branches.0.ahead_count", "0"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.0.branches.0.behind_count", "0"),
+				),
+			},
+		},
+	})
+}
+
 func hckGitRepositoriesDatSourceBasic(projectName, repoName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -105,3 +132,32 @@ data "azuredevops_git_repositories" "test" {
 }
 `, projectName, repoName)
 }
+
+func hckGitRepositoriesDatSourceStatistics(projectName, repoName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_git_repositories" "test" {
+  project_id         = azuredevops_project.test.id
+  include_branches   = true
+  include_statistics = true
+  exclude_disabled   = true
+  exclude_empty      = true
+  depends_on         = [azuredevops_git_repository.test]
+}
+`, projectName, repoName)
+}
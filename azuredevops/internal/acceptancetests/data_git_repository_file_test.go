@@ -0,0 +1,80 @@
+//go:build (all || data_sources || git || data_git_repository_file) && (!exclude_data_sources || !exclude_git || !data_git_repository_file)
+// +build all data_sources git data_git_repository_file
+// +build !exclude_data_sources !exclude_git !data_git_repository_file
+
+package acceptancetests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryFile_DataSource(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_git_repository_file.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testutils.PreCheck(t, nil) },
+		Providers:                 testutils.GetProviders(),
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataRepositoryFile(name, "foo.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "content", "bar"),
+					resource.TestCheckResourceAttr(tfNode, "size", "3"),
+					resource.TestCheckResourceAttrSet(tfNode, "object_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "last_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryFile_DataSource_notExist(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testutils.PreCheck(t, nil) },
+		Providers:                 testutils.GetProviders(),
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config:      hclDataRepositoryFile(name, "notExist.txt"),
+				ExpectError: regexp.MustCompile(`File notExist.txt does not exist in repository`),
+			},
+		},
+	})
+}
+
+func hclDataRepositoryFile(name, file string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%[1]s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_file" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  branch        = "refs/heads/master"
+  file          = "foo.txt"
+  content       = "bar"
+}
+
+data "azuredevops_git_repository_file" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  branch        = "master"
+  file          = "%[2]s"
+  depends_on    = [azuredevops_git_repository_file.test]
+}
+`, name, file)
+}
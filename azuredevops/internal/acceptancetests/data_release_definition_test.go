@@ -0,0 +1,43 @@
+//go:build (all || data_sources || data_release_definition) && (!exclude_data_sources || !exclude_data_release_definition)
+// +build all data_sources data_release_definition
+// +build !exclude_data_sources !exclude_data_release_definition
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccReleaseDefinition_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	definitionName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_release_definition" "release" {
+  project_id = azuredevops_project.project.id
+  name       = azuredevops_release_definition.release.name
+}`, testutils.HclReleaseDefinitionResource(projectName, definitionName, ""))
+
+	tfNode := "data.azuredevops_release_definition.release"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", definitionName),
+					resource.TestCheckResourceAttr(tfNode, "path", "\\"),
+					resource.TestCheckResourceAttr(tfNode, "stage.0.name", "dev"),
+					resource.TestCheckResourceAttr(tfNode, "artifact.0.alias", "_repo"),
+				),
+			},
+		},
+	})
+}
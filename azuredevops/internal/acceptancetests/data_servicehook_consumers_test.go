@@ -0,0 +1,34 @@
+//go:build (all || data_sources || data_servicehook_consumers) && (!data_sources || !exclude_data_servicehook_consumers)
+// +build all data_sources data_servicehook_consumers
+// +build !data_sources !exclude_data_servicehook_consumers
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookConsumers_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_servicehook_consumers.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "azuredevops_servicehook_consumers" "test" {
+  publisher_id = "tfs"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "consumers.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "consumers.*", map[string]string{
+						"id": "webHooks",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+//go:build (all || data_sources || data_servicehook_notifications) && (!data_sources || !exclude_data_servicehook_notifications)
+// +build all data_sources data_servicehook_notifications
+// +build !data_sources !exclude_data_servicehook_notifications
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Verifies that the notifications of a new subscription can be read. Nothing has been published yet,
+// so the subscription does not have any notification.
+func TestAccServicehookNotifications_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_servicehook_notifications.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookNotificationsDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "notifications.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclServicehookNotificationsDataSource(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_servicehook_webhook_git" "test" {
+  project_id = azuredevops_project.test.id
+  url        = "https://example.com/push"
+  git_push {}
+}
+
+data "azuredevops_servicehook_notifications" "test" {
+  subscription_id = azuredevops_servicehook_webhook_git.test.id
+  result          = "failed"
+  include_details = true
+}
+`, projectName)
+}
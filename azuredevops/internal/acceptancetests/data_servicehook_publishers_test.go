@@ -0,0 +1,31 @@
+//go:build (all || data_sources || data_servicehook_publishers) && (!data_sources || !exclude_data_servicehook_publishers)
+// +build all data_sources data_servicehook_publishers
+// +build !data_sources !exclude_data_servicehook_publishers
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookPublishers_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_servicehook_publishers.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "azuredevops_servicehook_publishers" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "publishers.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "publishers.*", map[string]string{
+						"id": "tfs",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+//go:build (all || data_sources || data_servicehook_subscriptions) && (!data_sources || !exclude_data_servicehook_subscriptions)
+// +build all data_sources data_servicehook_subscriptions
+// +build !data_sources !exclude_data_servicehook_subscriptions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookSubscriptions_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_servicehook_subscriptions.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookSubscriptionsDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(tfNode, "subscriptions.*.id", "azuredevops_servicehook_webhook_git.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func hclServicehookSubscriptionsDataSource(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_servicehook_webhook_git" "test" {
+  project_id = azuredevops_project.test.id
+  url        = "https://example.com/push"
+  git_push {}
+}
+
+data "azuredevops_servicehook_subscriptions" "test" {
+  publisher_id = "tfs"
+  event_type   = "git.push"
+  consumer_id  = "webHooks"
+
+  depends_on = [azuredevops_servicehook_webhook_git.test]
+}
+`, projectName)
+}
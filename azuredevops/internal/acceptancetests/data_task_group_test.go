@@ -0,0 +1,42 @@
+//go:build (all || data_sources || data_task_group) && (!exclude_data_sources || !exclude_data_task_group)
+// +build all data_sources data_task_group
+// +build !exclude_data_sources !exclude_data_task_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccTaskGroup_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	taskGroupName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_task_group" "task_group" {
+  project_id = azuredevops_project.project.id
+  name       = azuredevops_task_group.task_group.name
+}`, testutils.HclTaskGroupResource(projectName, taskGroupName, 1, false))
+
+	tfNode := "data.azuredevops_task_group.task_group"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_task_group.task_group", "id"),
+					resource.TestCheckResourceAttr(tfNode, "major_version", "1"),
+					resource.TestCheckResourceAttr(tfNode, "preview", "false"),
+					resource.TestCheckResourceAttrSet(tfNode, "version"),
+				),
+			},
+		},
+	})
+}
@@ -134,6 +134,115 @@ func TestAccBuildDefinition_Schedules(t *testing.T) {
 	})
 }
 
+func TestAccBuildDefinition_ClassicProcess(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_build_definition.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkBuildDefinitionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildDefinitionClassicProcess(name, "echo first"),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfNode, "repository.0.yml_path", ""),
+					resource.TestCheckResourceAttr(tfNode, "classic_process.0.phase.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "classic_process.0.phase.0.ref_name"),
+					resource.TestCheckResourceAttr(tfNode, "classic_process.0.phase.0.demands.0", "npm"),
+					resource.TestCheckResourceAttr(tfNode, "classic_process.0.phase.0.step.0.inputs.script", "echo first"),
+					resource.TestCheckResourceAttr(tfNode, "classic_process.0.phase.0.step.0.continue_on_error", "true"),
+				),
+			},
+			{
+				Config: hclBuildDefinitionClassicProcess(name, "echo second"),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfNode, "classic_process.0.phase.0.step.0.inputs.script", "echo second"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_first_run"},
+			},
+		},
+	})
+}
+
+func TestAccBuildDefinition_BuildCompletionTrigger(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_build_definition.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkBuildDefinitionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildDefinitionBuildCompletionTrigger(name),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfNode, "build_completion_trigger.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "build_completion_trigger.0.build_definition_id", "azuredevops_build_definition.upstream", "id"),
+					resource.TestCheckResourceAttr(tfNode, "build_completion_trigger.0.requires_successful_build", "true"),
+					resource.TestCheckResourceAttr(tfNode, "build_completion_trigger.0.branch_filter.#", "1"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_first_run"},
+			},
+		},
+	})
+}
+
+func TestAccBuildDefinition_Options(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_build_definition.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkBuildDefinitionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildDefinitionPath(name, `\\`),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfNode, "job_timeout_in_minutes", "60"),
+					resource.TestCheckResourceAttr(tfNode, "job_cancel_timeout_in_minutes", "5"),
+					resource.TestCheckResourceAttr(tfNode, "job_authorization_scope", "projectCollection"),
+					resource.TestCheckResourceAttr(tfNode, "badge_enabled", "false"),
+				),
+			},
+			{
+				Config: hclBuildDefinitionOptions(name),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfNode, "build_number_format", "$(Build.DefinitionName)_$(rev:r)"),
+					resource.TestCheckResourceAttr(tfNode, "job_timeout_in_minutes", "30"),
+					resource.TestCheckResourceAttr(tfNode, "job_cancel_timeout_in_minutes", "10"),
+					resource.TestCheckResourceAttr(tfNode, "job_authorization_scope", "project"),
+					resource.TestCheckResourceAttr(tfNode, "badge_enabled", "true"),
+					resource.TestCheckResourceAttr(tfNode, "retention_rule.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "retention_rule.0.days_to_keep", "30"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_first_run"},
+			},
+		},
+	})
+}
+
 // Checks that the expected variable values exist in the state
 func checkForVariableValues(tfNode string, expectedVals ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -345,3 +454,113 @@ resource "azuredevops_build_definition" "test" {
 }
 `, template, name)
 }
+
+func hclBuildDefinitionClassicProcess(name, script string) string {
+	template := hclBuildDefinitionTemplate(name)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_build_definition" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+  }
+
+  classic_process {
+    agent_specification = "ubuntu-latest"
+
+    phase {
+      name    = "Agent job 1"
+      demands = ["npm"]
+
+      step {
+        task_id           = "d9bafed4-0b18-4f58-968d-86655b4d2ce9"
+        task_version      = "2.*"
+        display_name      = "Command line"
+        continue_on_error = true
+        inputs = {
+          script = "%[3]s"
+        }
+      }
+    }
+  }
+}`, template, name, script)
+}
+
+func hclBuildDefinitionBuildCompletionTrigger(name string) string {
+	template := hclBuildDefinitionTemplate(name)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_build_definition" "upstream" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s-upstream"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+    yml_path    = "azure-pipelines.yml"
+  }
+}
+
+resource "azuredevops_build_definition" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+    yml_path    = "azure-pipelines.yml"
+  }
+
+  build_completion_trigger {
+    build_definition_id = azuredevops_build_definition.upstream.id
+    branch_filter {
+      include = ["refs/heads/main"]
+    }
+  }
+}`, template, name)
+}
+
+func hclBuildDefinitionOptions(name string) string {
+	template := hclBuildDefinitionTemplate(name)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_build_definition" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+  }
+
+  classic_process {
+    phase {
+      name = "Agent job 1"
+    }
+  }
+
+  build_number_format           = "$(Build.DefinitionName)_$(rev:r)"
+  job_timeout_in_minutes        = 30
+  job_cancel_timeout_in_minutes = 10
+  job_authorization_scope       = "project"
+  badge_enabled                 = true
+
+  retention_rule {
+    branches            = ["+refs/heads/*"]
+    days_to_keep        = 30
+    minimum_to_keep     = 5
+    delete_build_record = true
+    delete_test_results = true
+  }
+}`, template, name)
+}
@@ -0,0 +1,110 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+// +build all resource_deployment_group
+// +build !exclude_resource_deployment_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// Verifies that a deployment group can be created, updated and imported
+func TestAccDeploymentGroup_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	deploymentGroupName := testutils.GenerateResourceName()
+	deploymentGroupNameUpdated := testutils.GenerateResourceName()
+	tfNode := "azuredevops_deployment_group.deployment_group"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkDeploymentGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclDeploymentGroupResource(projectName, deploymentGroupName, "web servers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "pool_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "pool_name"),
+					resource.TestCheckResourceAttr(tfNode, "name", deploymentGroupName),
+					resource.TestCheckResourceAttr(tfNode, "description", "web servers"),
+					resource.TestCheckResourceAttr(tfNode, "machine_count", "0"),
+					checkDeploymentGroupExists(deploymentGroupName),
+				),
+			},
+			{
+				Config: testutils.HclDeploymentGroupResource(projectName, deploymentGroupNameUpdated, "database servers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", deploymentGroupNameUpdated),
+					resource.TestCheckResourceAttr(tfNode, "description", "database servers"),
+					checkDeploymentGroupExists(deploymentGroupNameUpdated),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// checkDeploymentGroupExists verifies that the deployment group in the state exists in AzDO with the expected name
+func checkDeploymentGroupExists(expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources["azuredevops_deployment_group.deployment_group"]
+		if !ok {
+			return fmt.Errorf("Did not find a deployment group in the TF state")
+		}
+
+		deploymentGroup, err := readDeploymentGroup(res.Primary.ID, res.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+		if *deploymentGroup.Name != expectedName {
+			return fmt.Errorf("Deployment group has name %s, but expected %s", *deploymentGroup.Name, expectedName)
+		}
+		return nil
+	}
+}
+
+// verifies that the deployment groups referenced in the state are destroyed
+func checkDeploymentGroupDestroyed(s *terraform.State) error {
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_deployment_group" {
+			continue
+		}
+
+		_, err := readDeploymentGroup(res.Primary.ID, res.Primary.Attributes["project_id"])
+		if err == nil {
+			return fmt.Errorf("Deployment group %s should not exist", res.Primary.ID)
+		}
+		if !utils.ResponseWasNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func readDeploymentGroup(id string, projectID string) (*taskagent.DeploymentGroup, error) {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+	deploymentGroupID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("Deployment group ID %s cannot be parsed: %v", id, err)
+	}
+	return clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+		Project:           converter.String(projectID),
+		DeploymentGroupId: &deploymentGroupID,
+	})
+}
@@ -0,0 +1,76 @@
+//go:build (all || core || resource_git_pull_request) && !exclude_resource_git_pull_request
+// +build all core resource_git_pull_request
+// +build !exclude_resource_git_pull_request
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitPullRequest_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_pull_request.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPullRequest(projectName, gitRepoName, "First title", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "pull_request_id"),
+					resource.TestCheckResourceAttr(tfNode, "title", "First title"),
+					resource.TestCheckResourceAttr(tfNode, "status", "active"),
+					resource.TestCheckResourceAttr(tfNode, "source_branch", "terraform/test"),
+					resource.TestCheckResourceAttrSet(tfNode, "source_commit_id"),
+				),
+			},
+			{
+				Config: hclGitPullRequest(projectName, gitRepoName, "Second title", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "title", "Second title"),
+					resource.TestCheckResourceAttr(tfNode, "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitPullRequest(projectName, gitRepoName, title, content string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_pull_request" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  source_branch = "terraform/test"
+  target_branch = "master"
+  title         = "%[3]s"
+  description   = "description"
+
+  file {
+    path    = "foo.txt"
+    content = "%[4]s"
+  }
+}
+`, projectName, gitRepoName, title, content)
+}
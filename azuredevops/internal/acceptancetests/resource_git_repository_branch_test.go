@@ -55,6 +55,47 @@ func TestAccGitRepoBranch_CreateAndUpdate(t *testing.T) {
 	)
 }
 
+func TestAccGitRepoBranch_LockAndImport(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	branchName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_repository_branch.locked"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoBranchLocked(projectName, gitRepoName, branchName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", fmt.Sprintf("testbranch-%s", branchName)),
+					resource.TestCheckResourceAttr(tfNode, "locked", "true"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref_branch", "imported"},
+			},
+			{
+				Config: hclGitRepoBranchLocked(projectName, gitRepoName, branchName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "locked", "false"),
+				),
+			},
+			{
+				// Locked branches are unlocked before they are deleted
+				Config: hclGitRepoBranchLocked(projectName, gitRepoName, branchName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "locked", "true"),
+				),
+			},
+		},
+	},
+	)
+}
+
 func TestAccGitRepoBranch_InvalidRef(t *testing.T) {
 	projectName := testutils.GenerateResourceName()
 	gitRepoName := testutils.GenerateResourceName()
@@ -105,6 +146,33 @@ resource "azuredevops_git_repository_branch" "from_commit_id" {
   `, projectName, gitRepoName, branchName)
 }
 
+func hclGitRepoBranchLocked(projectName, gitRepoName, branchName string, locked bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_branch" "locked" {
+  repository_id = azuredevops_git_repository.test.id
+  name          = "testbranch-%[3]s"
+  ref_branch    = "master"
+  locked        = %[4]t
+}
+  `, projectName, gitRepoName, branchName, locked)
+}
+
 func hclGitRepoBranchInvalidRef(projectName, gitRepoName, branchName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
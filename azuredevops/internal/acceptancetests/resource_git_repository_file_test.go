@@ -63,6 +63,41 @@ func TestAccGitRepoFile_CreateAndUpdate(t *testing.T) {
 	})
 }
 
+func TestAccGitRepoFile_CreateAndUpdateBase64(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfRepoFileNode := "azuredevops_git_repository_file.test"
+
+	branch := "refs/heads/master"
+	file := "foo.bin"
+	contentFirst := "bar"
+	contentSecond := "baz"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepositoryFileBase64(projectName, gitRepoName, branch, file, contentFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoFileNode, "file", file),
+					resource.TestCheckResourceAttrSet(tfRepoFileNode, "content_base64"),
+					resource.TestCheckNoResourceAttr(tfRepoFileNode, "content"),
+					checkGitRepoFileContent(contentFirst),
+				),
+			},
+			{
+				Config: hclGitRepositoryFileBase64(projectName, gitRepoName, branch, file, contentSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoFileNode, "file", file),
+					resource.TestCheckResourceAttrSet(tfRepoFileNode, "content_base64"),
+					checkGitRepoFileContent(contentSecond),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitRepoFile_IncorrectBranch(t *testing.T) {
 	projectName := testutils.GenerateResourceName()
 	gitRepoName := testutils.GenerateResourceName()
@@ -164,6 +199,33 @@ resource "azuredevops_git_repository_file" "test" {
 `, name, repoName, branch, file, content)
 }
 
+func hclGitRepositoryFileBase64(name, repoName, branch, file, content string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_file" "test" {
+  repository_id  = azuredevops_git_repository.test.id
+  branch         = "%[3]s"
+  file           = "%[4]s"
+  content_base64 = base64encode("%[5]s")
+}
+`, name, repoName, branch, file, content)
+}
+
 func hclGitRepositoryFileWithoutFile(name, repoName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
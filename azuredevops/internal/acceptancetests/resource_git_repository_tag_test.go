@@ -0,0 +1,87 @@
+//go:build (all || core || resource_git_repository_tag) && !exclude_resource_git_repository_tag
+// +build all core resource_git_repository_tag
+// +build !exclude_resource_git_repository_tag
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepoTag_CreateLightweightAndAnnotated(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tagName := testutils.GenerateResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoTags(projectName, gitRepoName, tagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.lightweight", "name", fmt.Sprintf("lightweight-%s", tagName)),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.lightweight", "annotated", "false"),
+					resource.TestCheckResourceAttrSet("azuredevops_git_repository_tag.lightweight", "commit_id"),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.annotated", "name", fmt.Sprintf("annotated-%s", tagName)),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.annotated", "annotated", "true"),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.annotated", "message", "Release baseline"),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.annotated", "tagger.#", "1"),
+					resource.TestCheckResourceAttrPair("azuredevops_git_repository_tag.annotated", "commit_id", "azuredevops_git_repository_tag.lightweight", "commit_id"),
+					resource.TestCheckResourceAttr("data.azuredevops_git_repository_refs.tags", "refs.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "azuredevops_git_repository_tag.annotated",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref_branch"},
+			},
+		},
+	},
+	)
+}
+
+func hclGitRepoTags(projectName, gitRepoName, tagName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_tag" "lightweight" {
+  repository_id = azuredevops_git_repository.test.id
+  name          = "lightweight-%[3]s"
+  ref_branch    = "master"
+  depends_on    = [azuredevops_git_repository.test]
+}
+
+resource "azuredevops_git_repository_tag" "annotated" {
+  repository_id = azuredevops_git_repository.test.id
+  name          = "annotated-%[3]s"
+  ref_commit_id = azuredevops_git_repository_tag.lightweight.commit_id
+  message       = "Release baseline"
+}
+
+data "azuredevops_git_repository_refs" "tags" {
+  repository_id = azuredevops_git_repository.test.id
+  filter        = "tags/"
+  depends_on    = [azuredevops_git_repository_tag.lightweight, azuredevops_git_repository_tag.annotated]
+}
+`, projectName, gitRepoName, tagName)
+}
@@ -199,6 +199,62 @@ func TestAccGitRepo_RepoFork_BranchNotEmpty(t *testing.T) {
 	})
 }
 
+func TestAccGitRepo_RepoFork_Sync(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	gitForkedRepoName := testutils.GenerateResourceName()
+	tfForkedRepoNode := "azuredevops_git_repository.gitforkedrepo"
+	forkedRepoResource := func(trigger string) string {
+		return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "gitforkedrepo" {
+  project_id           = azuredevops_project.project.id
+  parent_repository_id = azuredevops_git_repository.repository.id
+  name                 = "%s"
+  initialization {
+    init_type = "Uninitialized"
+  }
+  fork_sync {
+    branches = ["master"]
+    triggers = {
+      sync = "%s"
+    }
+  }
+}
+
+data "azuredevops_git_repository" "fork" {
+  project_id = azuredevops_project.project.id
+  name       = azuredevops_git_repository.gitforkedrepo.name
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"), gitForkedRepoName, trigger)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: forkedRepoResource("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfForkedRepoNode, "name", gitForkedRepoName),
+					resource.TestCheckResourceAttr(tfForkedRepoNode, "is_fork", "true"),
+					resource.TestCheckResourceAttr(tfForkedRepoNode, "default_branch", "refs/heads/master"),
+					resource.TestCheckResourceAttrPair("data.azuredevops_git_repository.fork", "parent_repository_id", "azuredevops_git_repository.repository", "id"),
+					resource.TestCheckResourceAttrPair("data.azuredevops_git_repository.fork", "parent_project_id", "azuredevops_project.project", "id"),
+				),
+			},
+			{
+				Config: forkedRepoResource("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfForkedRepoNode, "fork_sync.0.triggers.sync", "2"),
+					resource.TestCheckResourceAttr(tfForkedRepoNode, "fork_sync_drifted_branches.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitRepo_PrivateImport_BranchNotEmpty(t *testing.T) {
 	if os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME") == "" ||
 		os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD") == "" {
@@ -238,6 +294,98 @@ func TestAccGitRepo_PrivateImport_BranchNotEmpty(t *testing.T) {
 	})
 }
 
+func TestAccGitRepo_PrivateImportWithCredentials_BranchNotEmpty(t *testing.T) {
+	if os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME") == "" ||
+		os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD") == "" {
+		t.Skip("Skipping as AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME or AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD is not specified")
+	}
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	gitImportRepoName := testutils.GenerateResourceName()
+
+	tfImportRepoNode := "azuredevops_git_repository.import"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{
+				"AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME",
+				"AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD",
+			})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclProjectGitRepoImportPrivateWithCredentials(projectName, gitRepoName, gitImportRepoName,
+					os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME"),
+					os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD")),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					resource.TestCheckResourceAttrSet(tfImportRepoNode, "project_id"),
+					resource.TestCheckResourceAttr(tfImportRepoNode, "name", gitImportRepoName),
+					resource.TestCheckResourceAttr(tfImportRepoNode, "default_branch", "refs/heads/master"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies that a repository removed from the configuration can be restored from the
+// recycle bin, and that it is purged permanently on destroy if requested
+func TestAccGitRepo_RestoreFromRecycleBin(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfRepoNode := "azuredevops_git_repository.repository"
+	gitRepoResource := fmt.Sprintf(`
+	resource "azuredevops_git_repository" "repository" {
+		project_id = azuredevops_project.project.id
+		name       = "%s"
+		initialization {
+			init_type = "Clean"
+		}
+		features {
+			restore          = true
+			permanent_delete = true
+		}
+	}`, gitRepoName)
+	projectResource := testutils.HclProjectResource(projectName)
+
+	var repoID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					resource.TestCheckResourceAttrWith(tfRepoNode, "id", func(value string) error {
+						repoID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: projectResource,
+			},
+			{
+				Config: fmt.Sprintf("%s\n%s", projectResource, gitRepoResource),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					resource.TestCheckResourceAttrWith(tfRepoNode, "id", func(value string) error {
+						if value != repoID {
+							return fmt.Errorf("expected repository %s to be restored, got %s", repoID, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(tfRepoNode, "default_branch", "refs/heads/master"),
+				),
+			},
+		},
+	})
+}
+
 // or not the definition (1) exists in the state and (2) exist in AzDO and (3) has the correct name
 func checkGitRepoExists(expectedName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
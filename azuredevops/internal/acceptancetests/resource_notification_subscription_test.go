@@ -0,0 +1,133 @@
+//go:build (all || notification || resource_notification_subscription) && !exclude_resource_notification_subscription
+// +build all notification resource_notification_subscription
+// +build !exclude_resource_notification_subscription
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/notificationextras"
+)
+
+func TestAccNotificationSubscription_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_notification_subscription.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkNotificationSubscriptionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclNotificationSubscriptionBasic(projectName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "subscriber_id", "azuredevops_team.test", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "project_id", "azuredevops_project.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "channel_type", "EmailHtml"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "true"),
+				),
+			},
+			{
+				Config: hclNotificationSubscriptionUpdate(projectName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "criteria.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "criteria.1.logical_operator", "And"),
+					resource.TestCheckResourceAttr(tfNode, "email_address", "team@example.com"),
+					resource.TestCheckResourceAttr(tfNode, "block_user_opt_out", "true"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkNotificationSubscriptionDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_notification_subscription" {
+			continue
+		}
+
+		subscription, err := clients.NotificationClientExtras.GetSubscription(clients.Ctx, notificationextras.GetSubscriptionArgs{
+			SubscriptionId: converter.String(res.Primary.ID),
+		})
+		if err == nil && subscription.Status != nil && *subscription.Status != "pendingDeletion" {
+			return fmt.Errorf("Notification subscription %s should not exist", res.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func hclNotificationSubscriptionTemplate(projectName, teamName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_team" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%s"
+}
+`, projectName, teamName)
+}
+
+func hclNotificationSubscriptionBasic(projectName, teamName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_notification_subscription" "test" {
+  subscriber_id = azuredevops_team.test.id
+  project_id    = azuredevops_project.test.id
+  description   = "Build fails"
+  event_type    = "ms.vss-build.build-completed-event"
+  criteria {
+    field_name = "Status"
+    operator   = "="
+    value      = "Failed"
+  }
+}
+`, hclNotificationSubscriptionTemplate(projectName, teamName))
+}
+
+func hclNotificationSubscriptionUpdate(projectName, teamName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_notification_subscription" "test" {
+  subscriber_id      = azuredevops_team.test.id
+  project_id         = azuredevops_project.test.id
+  description        = "Build fails on main"
+  event_type         = "ms.vss-build.build-completed-event"
+  email_address      = "team@example.com"
+  block_user_opt_out = true
+  enabled            = false
+  criteria {
+    field_name = "Status"
+    operator   = "="
+    value      = "Failed"
+  }
+  criteria {
+    field_name = "Branch"
+    operator   = "="
+    value      = "refs/heads/main"
+  }
+}
+`, hclNotificationSubscriptionTemplate(projectName, teamName))
+}
@@ -164,6 +164,25 @@ func TestAccPipelineAuthorization_pipeline_variableGroup(t *testing.T) {
 	})
 }
 
+func TestAccPipelineAuthorization_pipeline_secureFile(t *testing.T) {
+	node := "azuredevops_pipeline_authorization.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, nil)
+		},
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclPipelineAuthSecureFile(testutils.GenerateResourceName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "project_id"),
+					resource.TestCheckResourceAttrSet(node, "resource_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPipelineAuthorization_allPipeline_endpoint(t *testing.T) {
 	node := "azuredevops_pipeline_authorization.test"
 	resource.ParallelTest(t, resource.TestCase{
@@ -589,6 +608,46 @@ resource "azuredevops_pipeline_authorization" "test" {
 }
 `, name)
 }
+func hclPipelineAuthSecureFile(name string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+  description        = "Managed by Terraform"
+}
+
+resource "azuredevops_secure_file" "test" {
+  project_id     = azuredevops_project.test.id
+  name           = "%[1]s.txt"
+  content_base64 = base64encode("content")
+}
+
+data "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+}
+
+resource "azuredevops_build_definition" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+
+  repository {
+    repo_type = "TfsGit"
+    repo_id   = data.azuredevops_git_repository.test.id
+    yml_path  = "azure-pipelines.yml"
+  }
+}
+
+resource "azuredevops_pipeline_authorization" "test" {
+  project_id  = azuredevops_project.test.id
+  resource_id = azuredevops_secure_file.test.id
+  type        = "securefile"
+  pipeline_id = azuredevops_build_definition.test.id
+}
+`, name)
+}
 func hclAllPipelineAuthEndpoint(name string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -0,0 +1,92 @@
+//go:build (all || resource_pipeline_run) && !exclude_resource_pipeline_run
+// +build all resource_pipeline_run
+// +build !exclude_resource_pipeline_run
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccPipelineRun_WaitForCompletion(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	pipelineName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_pipeline_run.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclPipelineRun(projectName, gitRepoName, pipelineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "name"),
+					resource.TestCheckResourceAttrSet(tfNode, "url"),
+					resource.TestCheckResourceAttr(tfNode, "state", "completed"),
+					resource.TestCheckResourceAttr(tfNode, "result", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func hclPipelineRun(projectName, gitRepoName, pipelineName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_file" "test" {
+  repository_id       = azuredevops_git_repository.test.id
+  file                = "azure-pipelines.yml"
+  content             = <<-YAML
+    parameters:
+    - name: message
+      type: string
+      default: default
+    trigger: none
+    pool:
+      vmImage: ubuntu-latest
+    steps:
+    - script: echo $${{ parameters.message }}
+  YAML
+  branch              = azuredevops_git_repository.test.default_branch
+  overwrite_on_create = true
+}
+
+resource "azuredevops_build_definition" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%s"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+    yml_path    = azuredevops_git_repository_file.test.file
+  }
+}
+
+resource "azuredevops_pipeline_run" "test" {
+  project_id  = azuredevops_project.test.id
+  pipeline_id = azuredevops_build_definition.test.id
+  branch      = azuredevops_git_repository.test.default_branch
+  template_parameters = {
+    message = "hello"
+  }
+  wait_for_completion = true
+}
+`, projectName, gitRepoName, pipelineName)
+}
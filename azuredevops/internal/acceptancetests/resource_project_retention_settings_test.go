@@ -0,0 +1,79 @@
+//go:build (all || core || resource_project || resource_project_retention_settings) && !exclude_resource_project_retention_settings
+// +build all core resource_project resource_project_retention_settings
+// +build !exclude_resource_project_retention_settings
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccProjectRetentionSettings_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_project_retention_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclProjectRetentionSettings(projectName, 30, 3, 30, 10, 14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_runs", "30"),
+					resource.TestCheckResourceAttr(tfNode, "runs_to_retain_per_protected_branch", "3"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_artifacts", "30"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_pull_request_runs", "10"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_deleted_releases", "14"),
+					resource.TestCheckResourceAttr(tfNode, "default_release_retention_policy.0.days_to_keep", "30"),
+					resource.TestCheckResourceAttr(tfNode, "default_release_retention_policy.0.releases_to_keep", "3"),
+				),
+			},
+			{
+				Config: hclProjectRetentionSettings(projectName, 60, 5, 20, 5, 21),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_runs", "60"),
+					resource.TestCheckResourceAttr(tfNode, "runs_to_retain_per_protected_branch", "5"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_artifacts", "20"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_pull_request_runs", "5"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_deleted_releases", "21"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclProjectRetentionSettings(projectName string, daysToKeepRuns, runsToRetain, daysToKeepArtifacts, daysToKeepPullRequestRuns, daysToKeepDeletedReleases int) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_project_retention_settings" "test" {
+  project_id                          = azuredevops_project.test.id
+  days_to_keep_runs                   = %d
+  runs_to_retain_per_protected_branch = %d
+  days_to_keep_artifacts              = %d
+  days_to_keep_pull_request_runs      = %d
+  days_to_keep_deleted_releases       = %d
+
+  default_release_retention_policy {
+    days_to_keep     = 30
+    releases_to_keep = 3
+    retain_build     = true
+  }
+}
+`, projectName, daysToKeepRuns, runsToRetain, daysToKeepArtifacts, daysToKeepPullRequestRuns, daysToKeepDeletedReleases)
+}
@@ -0,0 +1,124 @@
+//go:build (all || resource_release_definition) && !exclude_resource_release_definition
+// +build all resource_release_definition
+// +build !exclude_resource_release_definition
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccReleaseDefinition_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	definitionName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_release_definition.release"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkReleaseDefinitionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclReleaseDefinitionResource(projectName, definitionName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					checkReleaseDefinitionExists(definitionName),
+					resource.TestCheckResourceAttr(tfNode, "name", definitionName),
+					resource.TestCheckResourceAttr(tfNode, "artifact.0.is_primary", "true"),
+					resource.TestCheckResourceAttr(tfNode, "stage.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "stage.0.id"),
+					resource.TestCheckResourceAttrSet(tfNode, "revision"),
+				),
+			},
+			{
+				Config: testutils.HclReleaseDefinitionResource(projectName, definitionName, `
+  stage {
+    name         = "prod"
+    owner_id     = data.azuredevops_group.owner.origin_id
+    after_stages = ["dev"]
+
+    pre_deploy_approval {
+      approvers = [data.azuredevops_group.owner.origin_id]
+    }
+
+    job {
+      name                = "Agent job"
+      agent_pool_queue_id = data.azuredevops_agent_queue.queue.id
+      agent_specification = "ubuntu-latest"
+    }
+
+    retention {
+      days_to_keep     = 60
+      releases_to_keep = 5
+    }
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					checkReleaseDefinitionExists(definitionName),
+					resource.TestCheckResourceAttr(tfNode, "stage.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "stage.1.after_stages.0", "dev"),
+					resource.TestCheckResourceAttr(tfNode, "stage.1.pre_deploy_approval.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "stage.1.retention.0.days_to_keep", "60"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkReleaseDefinitionExists(expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources["azuredevops_release_definition.release"]
+		if !ok {
+			return fmt.Errorf("Did not find a release definition in the TF state")
+		}
+
+		definition, err := getReleaseDefinitionFromState(res)
+		if err != nil {
+			return err
+		}
+		if *definition.Name != expectedName {
+			return fmt.Errorf("Release definition has name=%s but expected name=%s", *definition.Name, expectedName)
+		}
+		return nil
+	}
+}
+
+func checkReleaseDefinitionDestroyed(s *terraform.State) error {
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_release_definition" {
+			continue
+		}
+
+		// the service keeps deleted definitions for a while, they are flagged as deleted
+		definition, err := getReleaseDefinitionFromState(res)
+		if err == nil && (definition.IsDeleted == nil || !*definition.IsDeleted) {
+			return fmt.Errorf("Release definition with ID %s should not exist", res.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func getReleaseDefinitionFromState(res *terraform.ResourceState) (*release.ReleaseDefinition, error) {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+	definitionID, err := strconv.Atoi(res.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Parse ID error, ID: %v. Error: %+v", res.Primary.ID, err)
+	}
+	return clients.ReleaseClient.GetReleaseDefinition(clients.Ctx, release.GetReleaseDefinitionArgs{
+		Project:      converter.String(res.Primary.Attributes["project_id"]),
+		DefinitionId: converter.Int(definitionID),
+	})
+}
@@ -0,0 +1,114 @@
+//go:build (all || resource_secure_file) && !exclude_resource_secure_file
+// +build all resource_secure_file
+// +build !exclude_resource_secure_file
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/taskagentextras"
+)
+
+func TestAccSecureFile_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	secureFileNameFirst := testutils.GenerateResourceName() + ".txt"
+	secureFileNameSecond := testutils.GenerateResourceName() + ".txt"
+	tfNode := "azuredevops_secure_file.secure_file"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkSecureFileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclSecureFileResource(projectName, secureFileNameFirst, "first", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "name", secureFileNameFirst),
+					resource.TestCheckResourceAttr(tfNode, "properties.team", "mobile"),
+					resource.TestCheckResourceAttr(tfNode, "authorize_all_pipelines", "false"),
+					checkSecureFileExists(secureFileNameFirst),
+				),
+			},
+			{
+				Config: testutils.HclSecureFileResource(projectName, secureFileNameSecond, "first", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", secureFileNameSecond),
+					resource.TestCheckResourceAttr(tfNode, "authorize_all_pipelines", "true"),
+					checkSecureFileExists(secureFileNameSecond),
+				),
+			},
+			{
+				Config: testutils.HclSecureFileResource(projectName, secureFileNameSecond, "second", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", secureFileNameSecond),
+					checkSecureFileExists(secureFileNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64"},
+			},
+		},
+	})
+}
+
+// checkSecureFileExists verifies that the secure file in the state exists in AzDO and has the expected name
+func checkSecureFileExists(expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources["azuredevops_secure_file.secure_file"]
+		if !ok {
+			return fmt.Errorf("Did not find a secure file in the TF state")
+		}
+
+		secureFile, err := readSecureFile(res.Primary.ID, res.Primary.Attributes["project_id"])
+		if err != nil {
+			return fmt.Errorf("Secure file with ID=%s cannot be found. Error=%v", res.Primary.ID, err)
+		}
+		if *secureFile.Name != expectedName {
+			return fmt.Errorf("Secure file with ID=%s has Name=%s, but expected Name=%s", res.Primary.ID, *secureFile.Name, expectedName)
+		}
+		return nil
+	}
+}
+
+// verifies that the secure files referenced in the state are destroyed
+func checkSecureFileDestroyed(s *terraform.State) error {
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_secure_file" {
+			continue
+		}
+
+		if _, err := readSecureFile(res.Primary.ID, res.Primary.Attributes["project_id"]); err == nil {
+			return fmt.Errorf("Secure file %s should not exist", res.Primary.ID)
+		} else if !utils.ResponseWasNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSecureFile(id string, projectID string) (*taskagent.SecureFile, error) {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+	secureFileID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("Secure file ID %s cannot be parsed: %v", id, err)
+	}
+	return clients.TaskAgentClientExtras.GetSecureFile(clients.Ctx, taskagentextras.GetSecureFileArgs{
+		Project:      converter.String(projectID),
+		SecureFileId: &secureFileID,
+	})
+}
@@ -0,0 +1,92 @@
+//go:build (all || resource_servicehook_service_bus_work_items) && !exclude_subscriptions
+// +build all resource_servicehook_service_bus_work_items
+// +build !exclude_subscriptions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookServiceBusWorkItems_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_service_bus_work_items.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServicehookSubscriptionDestroyed("azuredevops_servicehook_service_bus_work_items"),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookServiceBusWorkItemsCreated(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfCheckNode, "project_id"),
+					resource.TestCheckResourceAttr(tfCheckNode, "queue_name", "workitems"),
+					resource.TestCheckResourceAttr(tfCheckNode, "work_item_created.0.work_item_type", "Bug"),
+				),
+			},
+			{
+				Config: hclServicehookServiceBusWorkItemsUpdated(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfCheckNode, "queue_name", "workitems"),
+					resource.TestCheckResourceAttr(tfCheckNode, "send_as_non_serialized_string", "true"),
+					resource.TestCheckResourceAttr(tfCheckNode, "work_item_updated.0.changed_fields", "System.State"),
+					resource.TestCheckNoResourceAttr(tfCheckNode, "work_item_created.0.work_item_type"),
+				),
+			},
+			{
+				ResourceName:            tfCheckNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connection_string"},
+			},
+		},
+	})
+}
+
+func hclServicehookServiceBusWorkItemsTemplate(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+`, projectName)
+}
+
+func hclServicehookServiceBusWorkItemsCreated(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_servicehook_service_bus_work_items" "test" {
+  project_id        = azuredevops_project.test.id
+  connection_string = "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=secret"
+  queue_name        = "workitems"
+  work_item_created {
+    work_item_type = "Bug"
+  }
+}
+`, hclServicehookServiceBusWorkItemsTemplate(projectName))
+}
+
+func hclServicehookServiceBusWorkItemsUpdated(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_servicehook_service_bus_work_items" "test" {
+  project_id                    = azuredevops_project.test.id
+  connection_string             = "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=secret"
+  queue_name                    = "workitems"
+  send_as_non_serialized_string = true
+  work_item_updated {
+    area_path      = azuredevops_project.test.name
+    changed_fields = "System.State"
+  }
+}
+`, hclServicehookServiceBusWorkItemsTemplate(projectName))
+}
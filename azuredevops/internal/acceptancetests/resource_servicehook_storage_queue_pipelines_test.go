@@ -36,6 +36,8 @@ func TestAccServicehookStorageQueuePipelines_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(tfCheckNode, "account_key", accountKey),
 					resource.TestCheckResourceAttr(tfCheckNode, "stage_state_changed_event.0.stage_result_filter", resultFilter),
 					resource.TestCheckResourceAttr(tfCheckNode, "stage_state_changed_event.0.stage_state_filter", stateFilter),
+					resource.TestCheckResourceAttr(tfCheckNode, "status", "enabled"),
+					resource.TestCheckResourceAttr(tfCheckNode, "auto_reenable", "false"),
 				),
 			},
 		},
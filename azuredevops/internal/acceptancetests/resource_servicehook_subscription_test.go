@@ -0,0 +1,68 @@
+//go:build (all || resource_servicehook_subscription) && !exclude_subscriptions
+// +build all resource_servicehook_subscription
+// +build !exclude_subscriptions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookSubscription_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_subscription.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServicehookSubscriptionDestroyed("azuredevops_servicehook_subscription"),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookSubscription(projectName, "git.push", "https://example.com/push"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfCheckNode, "publisher_id", "tfs"),
+					resource.TestCheckResourceAttr(tfCheckNode, "event_type", "git.push"),
+					resource.TestCheckResourceAttr(tfCheckNode, "consumer_inputs.url", "https://example.com/push"),
+					resource.TestCheckResourceAttr(tfCheckNode, "status", "enabled"),
+				),
+			},
+			{
+				Config: hclServicehookSubscription(projectName, "git.pullrequest.created", "https://example.com/pr"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfCheckNode, "event_type", "git.pullrequest.created"),
+					resource.TestCheckResourceAttr(tfCheckNode, "consumer_inputs.url", "https://example.com/pr"),
+				),
+			},
+		},
+	})
+}
+
+func hclServicehookSubscription(projectName, eventType, url string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_servicehook_subscription" "test" {
+  publisher_id = "tfs"
+  event_type   = "%s"
+  publisher_inputs = {
+    projectId = azuredevops_project.test.id
+  }
+  consumer_id        = "webHooks"
+  consumer_action_id = "httpRequest"
+  consumer_inputs = {
+    url               = "%s"
+    basicAuthUsername = "user"
+    basicAuthPassword = "password"
+  }
+}
+`, projectName, eventType, url)
+}
@@ -0,0 +1,107 @@
+//go:build (all || resource_servicehook_webhook_git) && !exclude_subscriptions
+// +build all resource_servicehook_webhook_git
+// +build !exclude_subscriptions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookWebhookGit_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_webhook_git.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServicehookSubscriptionDestroyed("azuredevops_servicehook_webhook_git"),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookWebhookGitPush(projectName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfCheckNode, "project_id"),
+					resource.TestCheckResourceAttr(tfCheckNode, "url", "https://example.com/push"),
+					resource.TestCheckResourceAttr(tfCheckNode, "basic_auth_username", "user"),
+					resource.TestCheckResourceAttr(tfCheckNode, "git_push.0.branch", "main"),
+					resource.TestCheckResourceAttrPair(tfCheckNode, "git_push.0.repository_id", "azuredevops_git_repository.test", "id"),
+				),
+			},
+			{
+				Config: hclServicehookWebhookGitPullRequestMerged(projectName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfCheckNode, "url", "https://example.com/merged"),
+					resource.TestCheckResourceAttr(tfCheckNode, "resource_details_to_send", "minimal"),
+					resource.TestCheckResourceAttr(tfCheckNode, "git_pull_request_merge_attempted.0.merge_result", "Succeeded"),
+					resource.TestCheckNoResourceAttr(tfCheckNode, "git_push.0.branch"),
+				),
+			},
+			{
+				ResourceName:            tfCheckNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"basic_auth_password", "http_headers"},
+			},
+		},
+	})
+}
+
+func hclServicehookWebhookGitTemplate(projectName, repoName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+`, projectName, repoName)
+}
+
+func hclServicehookWebhookGitPush(projectName, repoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_servicehook_webhook_git" "test" {
+  project_id          = azuredevops_project.test.id
+  url                 = "https://example.com/push"
+  basic_auth_username = "user"
+  basic_auth_password = "password"
+  http_headers = {
+    X-Test = "test"
+  }
+  git_push {
+    repository_id = azuredevops_git_repository.test.id
+    branch        = "main"
+  }
+}
+`, hclServicehookWebhookGitTemplate(projectName, repoName))
+}
+
+func hclServicehookWebhookGitPullRequestMerged(projectName, repoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_servicehook_webhook_git" "test" {
+  project_id               = azuredevops_project.test.id
+  url                      = "https://example.com/merged"
+  resource_details_to_send = "minimal"
+  git_pull_request_merge_attempted {
+    repository_id = azuredevops_git_repository.test.id
+    merge_result  = "Succeeded"
+  }
+}
+`, hclServicehookWebhookGitTemplate(projectName, repoName))
+}
@@ -0,0 +1,121 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+// +build all resource_task_group
+// +build !exclude_resource_task_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// Verifies that a task group can be created, published as a new preview major version and promoted to stable
+func TestAccTaskGroup_CreateAndPublishMajorVersion(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	taskGroupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_task_group.task_group"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkTaskGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclTaskGroupResource(projectName, taskGroupName, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "name", taskGroupName),
+					resource.TestCheckResourceAttr(tfNode, "major_version", "1"),
+					resource.TestCheckResourceAttr(tfNode, "preview", "false"),
+					resource.TestCheckResourceAttr(tfNode, "input.0.name", "environment"),
+					resource.TestCheckResourceAttr(tfNode, "step.0.version", "3.*"),
+					checkTaskGroupVersionExists(1),
+				),
+			},
+			{
+				Config: testutils.HclTaskGroupResource(projectName, taskGroupName, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "major_version", "2"),
+					resource.TestCheckResourceAttr(tfNode, "preview", "true"),
+					checkTaskGroupVersionExists(1),
+					checkTaskGroupVersionExists(2),
+				),
+			},
+			{
+				Config: testutils.HclTaskGroupResource(projectName, taskGroupName, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "major_version", "2"),
+					resource.TestCheckResourceAttr(tfNode, "preview", "false"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_prior_versions"},
+			},
+		},
+	})
+}
+
+// checkTaskGroupVersionExists verifies that the given major version of the task group in the state exists in AzDO
+func checkTaskGroupVersionExists(majorVersion int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources["azuredevops_task_group.task_group"]
+		if !ok {
+			return fmt.Errorf("Did not find a task group in the TF state")
+		}
+
+		taskGroups, err := readTaskGroupVersions(res.Primary.ID, res.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+		for _, taskGroup := range *taskGroups {
+			if taskGroup.Version != nil && *taskGroup.Version.Major == majorVersion && !converter.ToBool(taskGroup.Deleted, false) {
+				return nil
+			}
+		}
+		return fmt.Errorf("Major version %d of task group %s does not exist", majorVersion, res.Primary.ID)
+	}
+}
+
+// verifies that the task groups referenced in the state are destroyed
+func checkTaskGroupDestroyed(s *terraform.State) error {
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_task_group" {
+			continue
+		}
+
+		taskGroups, err := readTaskGroupVersions(res.Primary.ID, res.Primary.Attributes["project_id"])
+		if err != nil {
+			continue
+		}
+		for _, taskGroup := range *taskGroups {
+			if !converter.ToBool(taskGroup.Deleted, false) {
+				return fmt.Errorf("Task group %s should not exist", res.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func readTaskGroupVersions(id string, projectID string) (*[]taskagent.TaskGroup, error) {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+	taskGroupID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("Task group ID %s cannot be parsed: %v", id, err)
+	}
+	return clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+		Project:     converter.String(projectID),
+		TaskGroupId: &taskGroupID,
+	})
+}
@@ -132,6 +132,24 @@ func HclProjectGitRepoImportPrivate(projectName, gitRepoName, gitImportRepoName,
 	return fmt.Sprintf("%s\n%s\n%s", gitRepoResource, serviceEndpointResource, importGitRepoResource)
 }
 
+// HclProjectGitRepoImportPrivateWithCredentials HCL describing a private AzDO GIT repository imported with username and password
+func HclProjectGitRepoImportPrivateWithCredentials(projectName, gitRepoName, gitImportRepoName, username, password string) string {
+	gitRepoResource := HclGitRepoResource(projectName, gitRepoName, "Clean")
+	importGitRepoResource := fmt.Sprintf(`
+	resource "azuredevops_git_repository" "import" {
+		project_id      = azuredevops_project.project.id
+		name            = "%s"
+		initialization {
+		   init_type   = "Import"
+		   source_type = "Git"
+		   source_url  = azuredevops_git_repository.repository.remote_url
+		   username    = "%s"
+		   password    = "%s"
+		 }
+	}`, gitImportRepoName, username, password)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, importGitRepoResource)
+}
+
 // HclSecurityroleDefinitionsDataSource HCL describing a data source for securityrole definitions
 func HclSecurityroleDefinitionsDataSource() string {
 	return `
@@ -669,6 +687,131 @@ resource "azuredevops_agent_queue" "q" {
 	return fmt.Sprintf("%s\n%s", poolHCL, queueHCL)
 }
 
+// HclReleaseDefinitionResource HCL describing an AzDO release definition with a Git artifact and a single stage
+func HclReleaseDefinitionResource(projectName, definitionName, additionalStages string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "owner" {
+  project_id = azuredevops_project.project.id
+  name       = "Project Administrators"
+}
+
+data "azuredevops_agent_queue" "queue" {
+  project_id = azuredevops_project.project.id
+  name       = "Azure Pipelines"
+}
+
+resource "azuredevops_release_definition" "release" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+
+  variable {
+    name  = "environment"
+    value = "test"
+  }
+
+  artifact {
+    alias = "_repo"
+    git {
+      project_id    = azuredevops_project.project.id
+      repository_id = azuredevops_git_repository.repository.id
+      branch        = "master"
+    }
+  }
+
+  continuous_deployment_trigger {
+    artifact_alias = "_repo"
+    branch_filters = ["master"]
+  }
+
+  stage {
+    name     = "dev"
+    owner_id = data.azuredevops_group.owner.origin_id
+
+    job {
+      name                = "Agent job"
+      agent_pool_queue_id = data.azuredevops_agent_queue.queue.id
+      agent_specification = "ubuntu-latest"
+
+      task {
+        task_id      = "6c731c3c-3c68-459a-a5c9-bde6e6595b5b"
+        version      = "3.*"
+        display_name = "Bash Script"
+        inputs = {
+          targetType = "inline"
+          script     = "echo $(environment)"
+        }
+      }
+    }
+  }
+%s
+}
+`, HclGitRepoResource(projectName, projectName+"-repo", "Clean"), definitionName, additionalStages)
+}
+
+// HclTaskGroupResource HCL describing an AzDO task group running a single Bash task
+func HclTaskGroupResource(projectName, taskGroupName string, majorVersion int, preview bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_task_group" "task_group" {
+  project_id    = azuredevops_project.project.id
+  name          = "%s"
+  major_version = %d
+  preview       = %t
+
+  input {
+    name          = "environment"
+    label         = "Environment"
+    default_value = "dev"
+    required      = true
+  }
+
+  step {
+    task_id      = "6c731c3c-3c68-459a-a5c9-bde6e6595b5b"
+    version      = "3.*"
+    display_name = "Bash Script"
+    inputs = {
+      targetType = "inline"
+      script     = "echo $(environment)"
+    }
+  }
+}
+`, HclProjectResource(projectName), taskGroupName, majorVersion, preview)
+}
+
+// HclSecureFileResource HCL describing an AzDO secure file
+func HclSecureFileResource(projectName, secureFileName, content string, authorizeAllPipelines bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_secure_file" "secure_file" {
+  project_id              = azuredevops_project.project.id
+  name                    = "%s"
+  content_base64          = base64encode("%s")
+  authorize_all_pipelines = %t
+
+  properties = {
+    team = "mobile"
+  }
+}
+`, HclProjectResource(projectName), secureFileName, content, authorizeAllPipelines)
+}
+
+// HclDeploymentGroupResource HCL describing an AzDO deployment group
+func HclDeploymentGroupResource(projectName, deploymentGroupName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_deployment_group" "deployment_group" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  description = "%s"
+}
+`, HclProjectResource(projectName), deploymentGroupName, description)
+}
+
 // HclBuildDefinitionResourceGitHub HCL describing an AzDO build definition sourced from GitHub
 func HclBuildDefinitionResourceGitHub(projectName string, buildDefinitionName string, buildPath string) string {
 	return HclBuildDefinitionResourceWithProject(
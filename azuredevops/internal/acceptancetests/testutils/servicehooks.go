@@ -0,0 +1,32 @@
+package testutils
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// CheckServicehookSubscriptionDestroyed verifies that all service hook subscriptions of the given type in the state are destroyed.
+// This will be invoked *after* terraform destroys the resource but *before* the state is wiped clean.
+func CheckServicehookSubscriptionDestroyed(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := GetProvider().Meta().(*client.AggregatedClient)
+		for _, res := range s.RootModule().Resources {
+			if res.Type != resourceType {
+				continue
+			}
+
+			// indicates the resource exists - this should fail the test
+			if _, err := clients.ServiceHooksClient.GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{
+				SubscriptionId: converter.UUID(res.Primary.ID),
+			}); err == nil {
+				return fmt.Errorf("Service hook subscription %s should not exist", res.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -29,9 +29,13 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/buildextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/notificationextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelineschecksextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/releaseextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/taskagentextras"
 	"github.com/microsoft/terraform-provider-azuredevops/version"
 )
 
@@ -66,6 +70,10 @@ type AggregatedClient struct {
 	WikiClient                    wiki.Client
 	WorkItemTrackingClient        workitemtracking.Client
 	ServiceHooksClient            servicehooks.Client
+	NotificationClientExtras      notificationextras.Client
+	BuildClientExtras             buildextras.Client
+	TaskAgentClientExtras         taskagentextras.Client
+	ReleaseClientExtras           releaseextras.Client
 	Ctx                           context.Context
 	SecurityRolesClient           securityroles.Client
 }
@@ -191,6 +199,18 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 
 	serviceHooksClient := servicehooks.NewClient(ctx, connection)
 
+	notificationClientExtras := notificationextras.NewClient(ctx, connection)
+
+	buildClientExtras := buildextras.NewClient(ctx, connection)
+
+	taskAgentClientExtras := taskagentextras.NewClient(ctx, connection)
+
+	releaseClientExtras, err := releaseextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): releaseextras.NewClient failed.")
+		return nil, err
+	}
+
 	securityRolesClient := securityroles.NewClient(ctx, connection)
 
 	aggregatedClient := &AggregatedClient{
@@ -217,6 +237,10 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 		WikiClient:                    wikiClient,
 		WorkItemTrackingClient:        workitemtrackingClient,
 		ServiceHooksClient:            serviceHooksClient,
+		NotificationClientExtras:      notificationClientExtras,
+		BuildClientExtras:             buildClientExtras,
+		TaskAgentClientExtras:         taskAgentClientExtras,
+		ReleaseClientExtras:           releaseClientExtras,
 		SecurityRolesClient:           securityRolesClient,
 		Ctx:                           ctx,
 	}
@@ -0,0 +1,262 @@
+package build
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/model"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/buildextras"
+)
+
+// DataBuildDefinitions schema and implementation for build definitions data source
+func DataBuildDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBuildDefinitionsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.Path,
+			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				RequiredWith: []string{"repository_type"},
+			},
+			"repository_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(model.RepoTypeValues.GitHub),
+					string(model.RepoTypeValues.TfsGit),
+					string(model.RepoTypeValues.Bitbucket),
+					string(model.RepoTypeValues.GitHubEnterprise),
+				}, false),
+			},
+			"yml_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"definitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"queue_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"yml_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"default_branch": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBuildDefinitionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	path := d.Get("path").(string)
+	recursive := d.Get("recursive").(bool)
+
+	args := buildextras.GetDefinitionsArgs{
+		Project: converter.String(projectID),
+	}
+	// the folder filter of the service does not include sub folders
+	if path != "" && !recursive {
+		args.Path = converter.String(path)
+	}
+	// the name filter of the service is a pattern supporting the wildcard `*`
+	if v, ok := d.GetOk("name"); ok {
+		args.Name = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("repository_id"); ok {
+		args.RepositoryId = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("repository_type"); ok {
+		args.RepositoryType = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("yml_path"); ok {
+		args.YamlFilename = converter.String(v.(string))
+	}
+
+	definitions, err := getAllBuildDefinitions(clients, args)
+	if err != nil {
+		return diag.Errorf(" reading build definitions of project %s: %+v", projectID, err)
+	}
+
+	if path != "" {
+		definitions = filterBuildDefinitionsByPath(definitions, path, recursive)
+	}
+
+	id, err := createBuildDefinitionsDataSourceID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("definitions", flattenBuildDefinitionList(definitions)); err != nil {
+		return diag.Errorf(" setting definitions: %+v", err)
+	}
+	return nil
+}
+
+// createBuildDefinitionsDataSourceID computes the ID from the project and the filters, so that data sources with
+// different filters have different IDs
+func createBuildDefinitionsDataSourceID(d *schema.ResourceData) (string, error) {
+	filters := []string{d.Get("project_id").(string)}
+	for _, key := range []string{"path", "recursive", "name", "repository_id", "repository_type", "yml_path"} {
+		filters = append(filters, fmt.Sprintf("%s=%v", key, d.Get(key)))
+	}
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(filters, "-"))); err != nil {
+		return "", fmt.Errorf(" Unable to compute hash for build definition filters: %v", err)
+	}
+	return "build-definitions#" + base64.URLEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// getAllBuildDefinitions returns all build definitions matching the args, following continuation tokens.
+func getAllBuildDefinitions(clients *client.AggregatedClient, args buildextras.GetDefinitionsArgs) ([]build.BuildDefinition, error) {
+	var definitions []build.BuildDefinition
+	for {
+		resp, err := clients.BuildClientExtras.GetDefinitions(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, resp.Value...)
+		if resp.ContinuationToken == "" {
+			return definitions, nil
+		}
+		args.ContinuationToken = converter.String(resp.ContinuationToken)
+	}
+}
+
+func filterBuildDefinitionsByPath(definitions []build.BuildDefinition, path string, recursive bool) []build.BuildDefinition {
+	path = strings.TrimSuffix(path, `\`)
+	result := []build.BuildDefinition{}
+	for _, definition := range definitions {
+		definitionPath := strings.TrimSuffix(converter.ToString(definition.Path, ""), `\`)
+		if strings.EqualFold(definitionPath, path) ||
+			(recursive && strings.HasPrefix(strings.ToLower(definitionPath), strings.ToLower(path+`\`))) {
+			result = append(result, definition)
+		}
+	}
+	return result
+}
+
+func flattenBuildDefinitionList(definitions []build.BuildDefinition) []interface{} {
+	results := make([]interface{}, 0, len(definitions))
+	for _, definition := range definitions {
+		result := map[string]interface{}{
+			"name":         converter.ToString(definition.Name, ""),
+			"path":         converter.ToString(definition.Path, ""),
+			"revision":     0,
+			"queue_status": "",
+			"yml_path":     "",
+		}
+		if definition.Id != nil {
+			result["id"] = *definition.Id
+		}
+		if definition.Revision != nil {
+			result["revision"] = *definition.Revision
+		}
+		if definition.QueueStatus != nil {
+			result["queue_status"] = string(*definition.QueueStatus)
+		}
+		if process, ok := definition.Process.(map[string]interface{}); ok {
+			if yamlFilename, ok := process["yamlFilename"].(string); ok {
+				result["yml_path"] = yamlFilename
+			}
+		}
+		if definition.Repository != nil {
+			result["repository"] = []interface{}{map[string]interface{}{
+				"id":             converter.ToString(definition.Repository.Id, ""),
+				"name":           converter.ToString(definition.Repository.Name, ""),
+				"type":           converter.ToString(definition.Repository.Type, ""),
+				"default_branch": converter.ToString(definition.Repository.DefaultBranch, ""),
+				"url":            converter.ToString(definition.Repository.Url, ""),
+			}}
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,141 @@
+//go:build (all || data_sources || data_build_definitions) && (!exclude_data_sources || !exclude_data_build_definitions)
+// +build all data_sources data_build_definitions
+// +build !exclude_data_sources !exclude_data_build_definitions
+
+package build
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/buildextras"
+	"github.com/stretchr/testify/require"
+)
+
+const testBuildDefinitionsProjectID = "00000000-0000-0000-0000-000000000001"
+
+func testBuildDefinitionsDefinition(id int, path string) build.BuildDefinition {
+	return build.BuildDefinition{
+		Id:          converter.Int(id),
+		Name:        converter.String("definition"),
+		Path:        converter.String(path),
+		Revision:    converter.Int(3),
+		QueueStatus: &build.DefinitionQueueStatusValues.Enabled,
+		Process:     map[string]interface{}{"type": float64(2), "yamlFilename": "azure-pipelines.yml"},
+		Repository: &build.BuildRepository{
+			Id:            converter.String("repoid"),
+			Name:          converter.String("repo"),
+			Type:          converter.String("TfsGit"),
+			DefaultBranch: converter.String("refs/heads/main"),
+		},
+	}
+}
+
+func TestDataSourceBuildDefinitions_Read_FollowsContinuationTokenAndFiltersPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockBuildextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClientExtras: mockClient, Ctx: context.Background()}
+
+	firstPage := mockClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, buildextras.GetDefinitionsArgs{
+			Project: converter.String(testBuildDefinitionsProjectID),
+			Name:    converter.String("def*"),
+		}).
+		Return(&buildextras.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinition{
+				testBuildDefinitionsDefinition(1, `\Team`),
+				testBuildDefinitionsDefinition(2, `\Other`),
+			},
+			ContinuationToken: "next",
+		}, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, buildextras.GetDefinitionsArgs{
+			Project:           converter.String(testBuildDefinitionsProjectID),
+			Name:              converter.String("def*"),
+			ContinuationToken: converter.String("next"),
+		}).
+		Return(&buildextras.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinition{
+				testBuildDefinitionsDefinition(3, `\Team\Sub`),
+				testBuildDefinitionsDefinition(4, `\TeamOther`),
+			},
+		}, nil).
+		After(firstPage).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataBuildDefinitions().Schema, map[string]interface{}{
+		"project_id": testBuildDefinitionsProjectID,
+		"path":       `\Team`,
+		"recursive":  true,
+		"name":       "def*",
+	})
+	diags := dataSourceBuildDefinitionsRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+
+	definitions := resourceData.Get("definitions").([]interface{})
+	require.Len(t, definitions, 2)
+	require.Equal(t, 1, definitions[0].(map[string]interface{})["id"])
+	require.Equal(t, 3, definitions[1].(map[string]interface{})["id"])
+	require.Equal(t, "azure-pipelines.yml", resourceData.Get("definitions.0.yml_path"))
+	require.Equal(t, "enabled", resourceData.Get("definitions.0.queue_status"))
+	require.Equal(t, "repoid", resourceData.Get("definitions.0.repository.0.id"))
+}
+
+func TestDataSourceBuildDefinitions_Read_PassesFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockBuildextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClientExtras: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, buildextras.GetDefinitionsArgs{
+			Project:        converter.String(testBuildDefinitionsProjectID),
+			Path:           converter.String(`\Team`),
+			RepositoryId:   converter.String("repoid"),
+			RepositoryType: converter.String("TfsGit"),
+			YamlFilename:   converter.String("azure-pipelines.yml"),
+		}).
+		Return(nil, errors.New("GetDefinitions() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataBuildDefinitions().Schema, map[string]interface{}{
+		"project_id":      testBuildDefinitionsProjectID,
+		"path":            `\Team`,
+		"repository_id":   "repoid",
+		"repository_type": "TfsGit",
+		"yml_path":        "azure-pipelines.yml",
+	})
+	diags := dataSourceBuildDefinitionsRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetDefinitions() Failed")
+}
+
+func TestDataSourceBuildDefinitions_DataSourceID_ContainsFilters(t *testing.T) {
+	all := schema.TestResourceDataRaw(t, DataBuildDefinitions().Schema, map[string]interface{}{
+		"project_id": testBuildDefinitionsProjectID,
+	})
+	filtered := schema.TestResourceDataRaw(t, DataBuildDefinitions().Schema, map[string]interface{}{
+		"project_id": testBuildDefinitionsProjectID,
+		"name":       "ci-*",
+	})
+
+	allID, err := createBuildDefinitionsDataSourceID(all)
+	require.Nil(t, err)
+	filteredID, err := createBuildDefinitionsDataSourceID(filtered)
+	require.Nil(t, err)
+	require.NotEqual(t, allID, filteredID)
+}
@@ -2,6 +2,7 @@ package build
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -31,6 +32,17 @@ const (
 	bdVariableAllowOverride = "allow_override"
 )
 
+const (
+	bdProcessTypeDesigner = 1
+	bdProcessTypeYaml     = 2
+
+	bdStepDefinitionTypeTask      = "task"
+	bdStepDefinitionTypeTaskGroup = "metaTask"
+
+	// agent jobs, as opposed to agentless (server) jobs
+	bdPhaseTargetTypeAgent = 1
+)
+
 // ResourceBuildDefinition schema and implementation for build definition resource
 func ResourceBuildDefinition() *schema.Resource {
 	filterSchema := map[string]*schema.Schema{
@@ -159,7 +171,7 @@ func ResourceBuildDefinition() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"yml_path": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 						"repo_id": {
 							Type:     schema.TypeString,
@@ -198,6 +210,140 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"classic_process": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_specification": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"phase": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"ref_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"condition": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "succeeded()",
+									},
+									"job_timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"job_cancel_timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"demands": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotWhiteSpace,
+										},
+									},
+									"step": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"task_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsUUID,
+												},
+												"task_version": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotWhiteSpace,
+												},
+												"definition_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      bdStepDefinitionTypeTask,
+													ValidateFunc: validation.StringInSlice([]string{bdStepDefinitionTypeTask, bdStepDefinitionTypeTaskGroup}, false),
+												},
+												"display_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "",
+												},
+												"ref_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "",
+												},
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"continue_on_error": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"always_run": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"condition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "succeeded()",
+												},
+												"timeout_in_minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      0,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"retry_count_on_task_failure": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      0,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"inputs": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"environment": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"ci_trigger": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -305,6 +451,26 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"build_completion_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"build_definition_id": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"branch_filter": branchFilter,
+						"requires_successful_build": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"schedules": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -364,6 +530,92 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"build_number_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"job_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"job_cancel_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"job_authorization_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(build.BuildAuthorizationScopeValues.ProjectCollection),
+					string(build.BuildAuthorizationScopeValues.Project),
+				}, false),
+			},
+			"badge_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"retention_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branches": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						"artifacts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						"artifact_types_to_delete": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						"days_to_keep": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"minimum_to_keep": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"delete_build_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"delete_test_results": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"queue_status": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -512,6 +764,10 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	d.Set("name", *buildDefinition.Name)
 	d.Set("path", *buildDefinition.Path)
 	d.Set("repository", flattenRepository(buildDefinition))
+	classicProcess := flattenClassicProcess(buildDefinition)
+	// the service adds the default values of all task inputs, only the configured inputs are kept
+	tfhelper.RemoveUnconfiguredMapKeys(classicProcess, d.Get("classic_process"), "inputs")
+	d.Set("classic_process", classicProcess)
 
 	if buildDefinition.Queue != nil && buildDefinition.Queue.Pool != nil {
 		d.Set("agent_pool_name", *buildDefinition.Queue.Pool.Name)
@@ -534,6 +790,10 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 		if triggers[build.DefinitionTriggerTypeValues.Schedule] != nil {
 			d.Set("schedules", triggers[build.DefinitionTriggerTypeValues.Schedule])
 		}
+
+		if triggers[build.DefinitionTriggerTypeValues.BuildCompletion] != nil {
+			d.Set("build_completion_trigger", triggers[build.DefinitionTriggerTypeValues.BuildCompletion])
+		}
 	}
 
 	revision := 0
@@ -544,6 +804,140 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	d.Set("revision", revision)
 
 	d.Set("queue_status", *buildDefinition.QueueStatus)
+
+	// the build number format and the job timeouts of a YAML pipeline are defined by the YAML file and not returned by
+	// the service, in this case the configured values are kept
+	if buildDefinition.BuildNumberFormat != nil {
+		d.Set("build_number_format", *buildDefinition.BuildNumberFormat)
+	}
+	if buildDefinition.JobTimeoutInMinutes != nil {
+		d.Set("job_timeout_in_minutes", *buildDefinition.JobTimeoutInMinutes)
+	}
+	if buildDefinition.JobCancelTimeoutInMinutes != nil {
+		d.Set("job_cancel_timeout_in_minutes", *buildDefinition.JobCancelTimeoutInMinutes)
+	}
+	if buildDefinition.JobAuthorizationScope != nil {
+		d.Set("job_authorization_scope", string(*buildDefinition.JobAuthorizationScope))
+	}
+	d.Set("badge_enabled", converter.ToBool(buildDefinition.BadgeEnabled, false))
+	d.Set("retention_rule", flattenRetentionRules(buildDefinition.RetentionRules))
+}
+
+func flattenRetentionRules(retentionRules *[]build.RetentionPolicy) []interface{} {
+	if retentionRules == nil {
+		return nil
+	}
+
+	rules := make([]interface{}, 0, len(*retentionRules))
+	for _, rule := range *retentionRules {
+		daysToKeep := 0
+		if rule.DaysToKeep != nil {
+			daysToKeep = *rule.DaysToKeep
+		}
+		minimumToKeep := 0
+		if rule.MinimumToKeep != nil {
+			minimumToKeep = *rule.MinimumToKeep
+		}
+		rules = append(rules, map[string]interface{}{
+			"branches":                 flattenStringListPointer(rule.Branches),
+			"artifacts":                flattenStringListPointer(rule.Artifacts),
+			"artifact_types_to_delete": flattenStringListPointer(rule.ArtifactTypesToDelete),
+			"days_to_keep":             daysToKeep,
+			"minimum_to_keep":          minimumToKeep,
+			"delete_build_record":      converter.ToBool(rule.DeleteBuildRecord, false),
+			"delete_test_results":      converter.ToBool(rule.DeleteTestResults, false),
+		})
+	}
+	return rules
+}
+
+func flattenStringListPointer(list *[]string) []interface{} {
+	if list == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*list))
+	for _, v := range *list {
+		result = append(result, v)
+	}
+	return result
+}
+
+func expandRetentionRules(d *schema.ResourceData) *[]build.RetentionPolicy {
+	rules := d.Get("retention_rule").([]interface{})
+	if len(rules) == 0 {
+		// an empty list removes the retention rules which are no longer configured
+		if d.HasChange("retention_rule") {
+			return &[]build.RetentionPolicy{}
+		}
+		return nil
+	}
+
+	configuredRules := configuredRetentionRuleAttributes(d)
+	retentionRules := make([]build.RetentionPolicy, 0, len(rules))
+	for i, rule := range rules {
+		ruleConfig := rule.(map[string]interface{})
+		branches := tfhelper.ExpandStringList(ruleConfig["branches"].([]interface{}))
+		if len(branches) == 0 {
+			branches = []string{"+refs/heads/*"}
+		}
+		artifacts := tfhelper.ExpandStringList(ruleConfig["artifacts"].([]interface{}))
+		artifactTypesToDelete := tfhelper.ExpandStringList(ruleConfig["artifact_types_to_delete"].([]interface{}))
+		retentionRule := build.RetentionPolicy{
+			Branches:              &branches,
+			Artifacts:             &artifacts,
+			ArtifactTypesToDelete: &artifactTypesToDelete,
+		}
+
+		// the settings of a rule are only sent if they are configured or known from the state, otherwise the
+		// defaults of the service are used
+		var configured map[string]bool
+		if i < len(configuredRules) {
+			configured = configuredRules[i]
+		}
+		if v := ruleConfig["days_to_keep"].(int); v != 0 || configured["days_to_keep"] {
+			retentionRule.DaysToKeep = converter.Int(v)
+		}
+		if v := ruleConfig["minimum_to_keep"].(int); v != 0 || configured["minimum_to_keep"] {
+			retentionRule.MinimumToKeep = converter.Int(v)
+		}
+		if v := ruleConfig["delete_build_record"].(bool); v || configured["delete_build_record"] {
+			retentionRule.DeleteBuildRecord = converter.Bool(v)
+		}
+		if v := ruleConfig["delete_test_results"].(bool); v || configured["delete_test_results"] {
+			retentionRule.DeleteTestResults = converter.Bool(v)
+		}
+		retentionRules = append(retentionRules, retentionRule)
+	}
+	return &retentionRules
+}
+
+// isConfigured returns whether the attribute is set in the configuration, so that a configured zero value can be
+// told apart from an attribute which is not configured
+func isConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	return !rawConfig.IsNull() && rawConfig.Type().HasAttribute(key) && !rawConfig.GetAttr(key).IsNull()
+}
+
+// configuredRetentionRuleAttributes returns the attributes set in the configuration of each retention rule
+func configuredRetentionRuleAttributes(d *schema.ResourceData) []map[string]bool {
+	if !isConfigured(d, "retention_rule") {
+		return nil
+	}
+	rules := d.GetRawConfig().GetAttr("retention_rule")
+	if !rules.IsKnown() {
+		return nil
+	}
+
+	var configuredRules []map[string]bool
+	for it := rules.ElementIterator(); it.Next(); {
+		_, rule := it.Element()
+		configured := map[string]bool{}
+		for key, v := range rule.AsValueMap() {
+			configured[key] = !v.IsNull()
+		}
+		configuredRules = append(configuredRules, configured)
+	}
+	return configuredRules
 }
 
 func flattenBuildVariables(d *schema.ResourceData, buildDefinition *build.BuildDefinition) interface{} {
@@ -599,7 +993,9 @@ func flattenRepository(buildDefinition *build.BuildDefinition) interface{} {
 	// available from the compiler is `interface{}` so we can probe for known
 	// implementations
 	if processMap, ok := buildDefinition.Process.(map[string]interface{}); ok {
-		yamlFilePath = processMap["yamlFilename"].(string)
+		if yamlFilename, ok := processMap["yamlFilename"].(string); ok {
+			yamlFilePath = yamlFilename
+		}
 	}
 	if yamlProcess, ok := buildDefinition.Process.(*build.YamlProcess); ok {
 		yamlFilePath = *yamlProcess.YamlFilename
@@ -745,6 +1141,29 @@ func flattenBuildDefinitionScheduleTrigger(ms map[string]interface{}) []interfac
 	return schedules
 }
 
+func flattenBuildDefinitionBuildCompletionTrigger(ms map[string]interface{}) interface{} {
+	buildDefinitionID := 0
+	if definition, ok := ms["definition"].(map[string]interface{}); ok {
+		switch id := definition["id"].(type) {
+		case float64:
+			buildDefinitionID = int(id)
+		case int:
+			buildDefinitionID = id
+		}
+	}
+
+	var branchFilter []interface{}
+	if branchFilters, ok := ms["branchFilters"].([]interface{}); ok && len(branchFilters) > 0 {
+		branchFilter = flattenBuildDefinitionBranchOrPathFilter(branchFilters)
+	}
+
+	return map[string]interface{}{
+		"build_definition_id":       buildDefinitionID,
+		"branch_filter":             branchFilter,
+		"requires_successful_build": ms["requiresSuccessfulBuild"],
+	}
+}
+
 func flattenTriggers(m *[]interface{}) map[build.DefinitionTriggerType][]interface{} {
 	buildTriggers := map[build.DefinitionTriggerType][]interface{}{}
 	for _, ds := range *m {
@@ -769,6 +1188,11 @@ func flattenTriggers(m *[]interface{}) map[build.DefinitionTriggerType][]interfa
 		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.Schedule)) {
 			buildTriggers[build.DefinitionTriggerTypeValues.Schedule] = flattenBuildDefinitionScheduleTrigger(trigger)
 		}
+		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.BuildCompletion)) {
+			buildTriggers[build.DefinitionTriggerTypeValues.BuildCompletion] = append(
+				buildTriggers[build.DefinitionTriggerTypeValues.BuildCompletion],
+				flattenBuildDefinitionBuildCompletionTrigger(trigger))
+		}
 	}
 	return buildTriggers
 }
@@ -929,6 +1353,19 @@ func expandBuildDefinitionTrigger(d map[string]interface{}, t build.DefinitionTr
 		}
 		scheduleConfig["daysToBuild"] = DateToDays(d["days_to_build"].([]interface{}))
 		return scheduleConfig
+	case build.DefinitionTriggerTypeValues.BuildCompletion:
+		branchFilters := expandBuildDefinitionBranchOrPathFilterSet(d["branch_filter"].(*schema.Set))
+		if branchFilters == nil {
+			branchFilters = []interface{}{}
+		}
+		return map[string]interface{}{
+			"branchFilters": branchFilters,
+			"definition": map[string]interface{}{
+				"id": d["build_definition_id"].(int),
+			},
+			"requiresSuccessfulBuild": d["requires_successful_build"].(bool),
+			"triggerType":             string(t),
+		}
 	}
 	return nil
 }
@@ -1045,6 +1482,12 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		buildTriggers = append(buildTriggers, scheduleTriggers)
 	}
 
+	buildCompletionTriggers := expandBuildDefinitionTriggerList(
+		d.Get("build_completion_trigger").([]interface{}),
+		build.DefinitionTriggerTypeValues.BuildCompletion,
+	)
+	buildTriggers = append(buildTriggers, buildCompletionTriggers...)
+
 	// Look for the ID. This may not exist if we are within the context of a "create" operation,
 	// so it is OK if it is missing.
 	buildDefinitionID, err := strconv.Atoi(d.Id())
@@ -1062,6 +1505,11 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 
 	queueStatus := build.DefinitionQueueStatus(d.Get("queue_status").(string))
 
+	process, err := expandBuildDefinitionProcess(d, repository["yml_path"].(string))
+	if err != nil {
+		return nil, "", err
+	}
+
 	buildDefinition := build.BuildDefinition{
 		Id:       buildDefinitionReference,
 		Name:     converter.String(d.Get("name").(string)),
@@ -1079,10 +1527,10 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 				"reportBuildStatus":  strconv.FormatBool(repository["report_build_status"].(bool)),
 			},
 		},
-		Process: &build.YamlProcess{
-			YamlFilename: converter.String(repository["yml_path"].(string)),
-		},
+		Process:        process,
 		QueueStatus:    &queueStatus,
+		BadgeEnabled:   converter.Bool(d.Get("badge_enabled").(bool)),
+		RetentionRules: expandRetentionRules(d),
 		Type:           &build.DefinitionTypeValues.Build,
 		Quality:        &build.DefinitionQualityValues.Definition,
 		VariableGroups: expandVariableGroups(d),
@@ -1090,6 +1538,22 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		Triggers:       &buildTriggers,
 	}
 
+	// the options are only sent if they are configured or known from the state, otherwise the defaults of the
+	// service are used
+	if v, ok := d.GetOk("build_number_format"); ok {
+		buildDefinition.BuildNumberFormat = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("job_timeout_in_minutes"); ok || isConfigured(d, "job_timeout_in_minutes") {
+		buildDefinition.JobTimeoutInMinutes = converter.Int(v.(int))
+	}
+	if v, ok := d.GetOk("job_cancel_timeout_in_minutes"); ok {
+		buildDefinition.JobCancelTimeoutInMinutes = converter.Int(v.(int))
+	}
+	if v, ok := d.GetOk("job_authorization_scope"); ok {
+		jobAuthorizationScope := build.BuildAuthorizationScope(v.(string))
+		buildDefinition.JobAuthorizationScope = &jobAuthorizationScope
+	}
+
 	if agentPoolName, ok := d.GetOk("agent_pool_name"); ok {
 		buildDefinition.Queue = &build.AgentPoolQueue{
 			Name: converter.StringFromInterface(agentPoolName),
@@ -1135,3 +1599,177 @@ func buildDefinitionFeatures(d *schema.ResourceData) map[string]interface{} {
 	}
 	return nil
 }
+
+// expandBuildDefinitionProcess returns a YAML process or, if configured, a classic (designer) process. The designer
+// process is expanded into its JSON representation because the SDK models lack the demands of the phase targets.
+func expandBuildDefinitionProcess(d *schema.ResourceData, yamlPath string) (interface{}, error) {
+	classicProcess := d.Get("classic_process").([]interface{})
+	if len(classicProcess) == 0 || classicProcess[0] == nil {
+		if yamlPath == "" {
+			return nil, errors.New("one of `repository.0.yml_path` and `classic_process` must be configured")
+		}
+		return &build.YamlProcess{
+			YamlFilename: converter.String(yamlPath),
+		}, nil
+	}
+	if yamlPath != "" {
+		return nil, errors.New("`repository.0.yml_path` can not be configured together with `classic_process`")
+	}
+
+	processConfig := classicProcess[0].(map[string]interface{})
+	phases := []interface{}{}
+	for _, phase := range processConfig["phase"].([]interface{}) {
+		phases = append(phases, expandClassicProcessPhase(phase.(map[string]interface{})))
+	}
+
+	process := map[string]interface{}{
+		"type":   bdProcessTypeDesigner,
+		"phases": phases,
+	}
+	if agentSpecification := processConfig["agent_specification"].(string); agentSpecification != "" {
+		process["target"] = map[string]interface{}{
+			"agentSpecification": map[string]interface{}{
+				"identifier": agentSpecification,
+			},
+		}
+	}
+	return process, nil
+}
+
+func expandClassicProcessPhase(phaseConfig map[string]interface{}) map[string]interface{} {
+	steps := []interface{}{}
+	for _, step := range phaseConfig["step"].([]interface{}) {
+		steps = append(steps, expandClassicProcessStep(step.(map[string]interface{})))
+	}
+
+	demands := []interface{}{}
+	for _, demand := range phaseConfig["demands"].([]interface{}) {
+		demands = append(demands, demand.(string))
+	}
+
+	phase := map[string]interface{}{
+		"name":                      phaseConfig["name"].(string),
+		"condition":                 phaseConfig["condition"].(string),
+		"jobTimeoutInMinutes":       phaseConfig["job_timeout_in_minutes"].(int),
+		"jobCancelTimeoutInMinutes": phaseConfig["job_cancel_timeout_in_minutes"].(int),
+		"steps":                     steps,
+		"target": map[string]interface{}{
+			"type":    bdPhaseTargetTypeAgent,
+			"demands": demands,
+		},
+	}
+	if refName := phaseConfig["ref_name"].(string); refName != "" {
+		phase["refName"] = refName
+	}
+	return phase
+}
+
+func expandClassicProcessStep(stepConfig map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"task": map[string]interface{}{
+			"id":             stepConfig["task_id"].(string),
+			"versionSpec":    stepConfig["task_version"].(string),
+			"definitionType": stepConfig["definition_type"].(string),
+		},
+		"displayName":             stepConfig["display_name"].(string),
+		"refName":                 stepConfig["ref_name"].(string),
+		"enabled":                 stepConfig["enabled"].(bool),
+		"continueOnError":         stepConfig["continue_on_error"].(bool),
+		"alwaysRun":               stepConfig["always_run"].(bool),
+		"condition":               stepConfig["condition"].(string),
+		"timeoutInMinutes":        stepConfig["timeout_in_minutes"].(int),
+		"retryCountOnTaskFailure": stepConfig["retry_count_on_task_failure"].(int),
+		"inputs":                  *tfhelper.ExpandStringMap(stepConfig["inputs"].(map[string]interface{})),
+		"environment":             *tfhelper.ExpandStringMap(stepConfig["environment"].(map[string]interface{})),
+	}
+}
+
+// classicProcess is the designer process of a build definition. The phases of a build definition target agent pool
+// queues, which also contain the demands of a phase.
+type classicProcess struct {
+	build.DesignerProcess
+	Phases *[]classicProcessPhase `json:"phases,omitempty"`
+}
+
+type classicProcessPhase struct {
+	build.Phase
+	Target *build.AgentPoolQueueTarget `json:"target,omitempty"`
+}
+
+// flattenClassicProcess flattens the designer process of a build definition. The service returns the process as
+// decoded JSON, it is converted to the models of the SDK first.
+func flattenClassicProcess(buildDefinition *build.BuildDefinition) []interface{} {
+	var process classicProcess
+	data, err := json.Marshal(buildDefinition.Process)
+	if err != nil || json.Unmarshal(data, &process) != nil || converter.ToInt(process.Type, 0) != bdProcessTypeDesigner {
+		return nil
+	}
+
+	phases := []interface{}{}
+	if process.Phases != nil {
+		for _, phase := range *process.Phases {
+			phases = append(phases, flattenClassicProcessPhase(phase))
+		}
+	}
+
+	agentSpecification := ""
+	if process.Target != nil && process.Target.AgentSpecification != nil {
+		agentSpecification = converter.ToString(process.Target.AgentSpecification.Identifier, "")
+	}
+
+	return []interface{}{map[string]interface{}{
+		"agent_specification": agentSpecification,
+		"phase":               phases,
+	}}
+}
+
+func flattenClassicProcessPhase(phase classicProcessPhase) map[string]interface{} {
+	steps := []interface{}{}
+	if phase.Steps != nil {
+		for _, step := range *phase.Steps {
+			steps = append(steps, flattenClassicProcessStep(step))
+		}
+	}
+
+	demands := []interface{}{}
+	if phase.Target != nil && phase.Target.Demands != nil {
+		demands = *phase.Target.Demands
+	}
+
+	return map[string]interface{}{
+		"name":                          converter.ToString(phase.Name, ""),
+		"ref_name":                      converter.ToString(phase.RefName, ""),
+		"condition":                     converter.ToString(phase.Condition, ""),
+		"job_timeout_in_minutes":        converter.ToInt(phase.JobTimeoutInMinutes, 0),
+		"job_cancel_timeout_in_minutes": converter.ToInt(phase.JobCancelTimeoutInMinutes, 0),
+		"demands":                       demands,
+		"step":                          steps,
+	}
+}
+
+func flattenClassicProcessStep(step build.BuildDefinitionStep) map[string]interface{} {
+	result := map[string]interface{}{
+		"display_name":                converter.ToString(step.DisplayName, ""),
+		"ref_name":                    converter.ToString(step.RefName, ""),
+		"enabled":                     converter.ToBool(step.Enabled, false),
+		"continue_on_error":           converter.ToBool(step.ContinueOnError, false),
+		"always_run":                  converter.ToBool(step.AlwaysRun, false),
+		"condition":                   converter.ToString(step.Condition, ""),
+		"timeout_in_minutes":          converter.ToInt(step.TimeoutInMinutes, 0),
+		"retry_count_on_task_failure": converter.ToInt(step.RetryCountOnTaskFailure, 0),
+	}
+	if step.Inputs != nil {
+		result["inputs"] = *step.Inputs
+	}
+	if step.Environment != nil {
+		result["environment"] = *step.Environment
+	}
+	if task := step.Task; task != nil {
+		if task.Id != nil {
+			result["task_id"] = task.Id.String()
+		}
+		result["task_version"] = converter.ToString(task.VersionSpec, "")
+		result["definition_type"] = converter.ToString(task.DefinitionType, "")
+	}
+	return result
+}
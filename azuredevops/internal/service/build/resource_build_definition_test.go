@@ -6,6 +6,7 @@ package build
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -14,6 +15,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -84,9 +86,30 @@ var yamlPrTrigger = map[string]interface{}{
 	"triggerType":                          "pullRequest",
 }
 
+var buildCompletionTrigger = map[string]interface{}{
+	"branchFilters": []interface{}{
+		"+refs/heads/main",
+		"-refs/heads/test",
+	},
+	"definition": map[string]interface{}{
+		"id": 10,
+	},
+	"requiresSuccessfulBuild": true,
+	"triggerType":             "buildCompletion",
+}
+
+var buildCompletionTriggerWithoutBranchFilter = map[string]interface{}{
+	"branchFilters": []interface{}{},
+	"definition": map[string]interface{}{
+		"id": 11,
+	},
+	"requiresSuccessfulBuild": false,
+	"triggerType":             "buildCompletion",
+}
+
 var triggerGroups = [][]interface{}{
 	{manualCiTrigger, manualPrTrigger},
-	{yamlCiTrigger, yamlPrTrigger},
+	{yamlCiTrigger, yamlPrTrigger, buildCompletionTrigger, buildCompletionTriggerWithoutBranchFilter},
 }
 
 // This definition matches the overall structure of what a configured git repository would
@@ -118,11 +141,16 @@ var testBuildDefinition = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	Triggers:       &[]interface{}{},
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	BuildNumberFormat:         converter.String("$(date:yyyyMMdd)$(rev:.r)"),
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	Triggers:                  &[]interface{}{},
+	VariableGroups:            &[]build.VariableGroup{},
 }
 
 // This definition matches the overall structure of what a configured Bitbucket git repository would
@@ -156,10 +184,15 @@ var testBuildDefinitionBitbucketWithCITrigger = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	BuildNumberFormat:         converter.String("$(date:yyyyMMdd)$(rev:.r)"),
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	VariableGroups:            &[]build.VariableGroup{},
 }
 
 // This definition matches the overall structure of what a configured GitHub Enterprise git repository would
@@ -194,10 +227,15 @@ var testBuildDefinitionGitHubEnterpriseWithCITrigger = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	BuildNumberFormat:         converter.String("$(date:yyyyMMdd)$(rev:.r)"),
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	VariableGroups:            &[]build.VariableGroup{},
 }
 
 // This definition matches the overall structure of what a configured Bitbucket git repository would
@@ -228,10 +266,15 @@ func testBuildDefinitionBitbucket() build.BuildDefinition {
 				Name: converter.String("BuildPoolName"),
 			},
 		},
-		QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-		Type:           &build.DefinitionTypeValues.Build,
-		Quality:        &build.DefinitionQualityValues.Definition,
-		VariableGroups: &[]build.VariableGroup{},
+		QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+		BuildNumberFormat:         converter.String("$(date:yyyyMMdd)$(rev:.r)"),
+		JobTimeoutInMinutes:       converter.Int(60),
+		JobCancelTimeoutInMinutes: converter.Int(5),
+		JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+		BadgeEnabled:              converter.Bool(false),
+		Type:                      &build.DefinitionTypeValues.Build,
+		Quality:                   &build.DefinitionQualityValues.Definition,
+		VariableGroups:            &[]build.VariableGroup{},
 	}
 }
 
@@ -264,10 +307,15 @@ func testBuildDefinitionGitHubEnterprise() build.BuildDefinition {
 				Name: converter.String("BuildPoolName"),
 			},
 		},
-		QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-		Type:           &build.DefinitionTypeValues.Build,
-		Quality:        &build.DefinitionQualityValues.Definition,
-		VariableGroups: &[]build.VariableGroup{},
+		QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+		BuildNumberFormat:         converter.String("$(date:yyyyMMdd)$(rev:.r)"),
+		JobTimeoutInMinutes:       converter.Int(60),
+		JobCancelTimeoutInMinutes: converter.Int(5),
+		JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+		BadgeEnabled:              converter.Bool(false),
+		Type:                      &build.DefinitionTypeValues.Build,
+		Quality:                   &build.DefinitionQualityValues.Definition,
+		VariableGroups:            &[]build.VariableGroup{},
 	}
 }
 
@@ -557,6 +605,228 @@ func TestExpandVariables_CatchesDuplicateVariables(t *testing.T) {
 	require.Contains(t, err.Error(), "Unexpectedly found duplicate variable with name")
 }
 
+// a classic process as it is returned by the service, i.e. decoded from JSON
+const testClassicProcessJSON = `{
+	"type": 1,
+	"target": {"agentSpecification": {"identifier": "ubuntu-latest"}},
+	"phases": [{
+		"name": "Agent job 1",
+		"refName": "Job_1",
+		"condition": "succeeded()",
+		"jobTimeoutInMinutes": 60,
+		"jobCancelTimeoutInMinutes": 5,
+		"target": {"type": 1, "demands": ["npm", "Agent.OS -equals Linux"]},
+		"steps": [{
+			"task": {"id": "d9bafed4-0b18-4f58-968d-86655b4d2ce9", "versionSpec": "2.*", "definitionType": "task"},
+			"displayName": "Run script",
+			"refName": "",
+			"enabled": true,
+			"continueOnError": true,
+			"alwaysRun": false,
+			"condition": "succeeded()",
+			"timeoutInMinutes": 10,
+			"retryCountOnTaskFailure": 2,
+			"inputs": {"script": "echo hello", "workingDirectory": ""},
+			"environment": {"GREETING": "hello"}
+		}, {
+			"task": {"id": "00000000-0000-0000-0000-000000000001", "versionSpec": "1.*", "definitionType": "metaTask"},
+			"displayName": "Task group",
+			"refName": "",
+			"enabled": false,
+			"continueOnError": false,
+			"alwaysRun": true,
+			"condition": "always()",
+			"timeoutInMinutes": 0,
+			"retryCountOnTaskFailure": 0,
+			"inputs": {},
+			"environment": {}
+		}]
+	}]
+}`
+
+// verifies that a classic process returned by the service survives the flatten/expand round trip
+func TestBuildDefinition_ClassicProcess_FlattenExpandRoundtrip(t *testing.T) {
+	var process map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(testClassicProcessJSON), &process))
+
+	buildDefinition := testBuildDefinition
+	buildDefinition.Process = process
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+	require.Equal(t, "", resourceData.Get("repository.0.yml_path"))
+	require.Equal(t, "ubuntu-latest", resourceData.Get("classic_process.0.agent_specification"))
+	require.Equal(t, "Job_1", resourceData.Get("classic_process.0.phase.0.ref_name"))
+	require.Equal(t, "npm", resourceData.Get("classic_process.0.phase.0.demands.0"))
+	require.Equal(t, "metaTask", resourceData.Get("classic_process.0.phase.0.step.1.definition_type"))
+
+	buildDefinitionAfterRoundTrip, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+
+	// compare the JSON representations, as the service would receive them
+	processAfterRoundTrip, err := json.Marshal(buildDefinitionAfterRoundTrip.Process)
+	require.Nil(t, err)
+	require.JSONEq(t, testClassicProcessJSON, string(processAfterRoundTrip))
+}
+
+// verifies that the default values of task inputs added by the service are only kept if they are configured
+func TestBuildDefinition_ClassicProcess_FlattenKeepsConfiguredInputs(t *testing.T) {
+	var process map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(testClassicProcessJSON), &process))
+
+	buildDefinition := testBuildDefinition
+	buildDefinition.Process = process
+
+	// without a configuration, e.g. after an import, all inputs are read
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+	require.Len(t, resourceData.Get("classic_process.0.phase.0.step.0.inputs").(map[string]interface{}), 2)
+
+	classicProcess := resourceData.Get("classic_process").([]interface{})
+	step := classicProcess[0].(map[string]interface{})["phase"].([]interface{})[0].(map[string]interface{})["step"].([]interface{})[0].(map[string]interface{})
+	step["inputs"] = map[string]interface{}{"script": "echo hello"}
+	require.Nil(t, resourceData.Set("classic_process", classicProcess))
+
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+	require.Len(t, resourceData.Get("classic_process.0.phase.0.step.0.inputs").(map[string]interface{}), 1)
+	require.Equal(t, "echo hello", resourceData.Get("classic_process.0.phase.0.step.0.inputs.script"))
+}
+
+// verifies that exactly one of a YAML file and a classic process is required
+func TestBuildDefinition_Expand_RequiresExactlyOneProcess(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	resourceData.Set("classic_process", []interface{}{map[string]interface{}{
+		"phase": []interface{}{map[string]interface{}{"name": "Agent job 1"}},
+	}})
+
+	_, _, err := expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "can not be configured together with `classic_process`")
+
+	resourceData.Set("repository", []interface{}{map[string]interface{}{
+		"repo_type": "GitHub",
+		"repo_id":   "RepoId",
+		"yml_path":  "",
+	}})
+	resourceData.Set("classic_process", nil)
+	_, _, err = expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "one of `repository.0.yml_path` and `classic_process` must be configured")
+}
+
+// verifies that the definition options and retention rules survive the flatten/expand round trip
+func TestBuildDefinition_Options_FlattenExpandRoundtrip(t *testing.T) {
+	buildDefinition := testBuildDefinition
+	buildDefinition.BuildNumberFormat = converter.String("$(Build.DefinitionName)_$(rev:r)")
+	buildDefinition.JobTimeoutInMinutes = converter.Int(30)
+	buildDefinition.JobCancelTimeoutInMinutes = converter.Int(10)
+	buildDefinition.JobAuthorizationScope = &build.BuildAuthorizationScopeValues.Project
+	buildDefinition.BadgeEnabled = converter.Bool(true)
+	buildDefinition.RetentionRules = &[]build.RetentionPolicy{
+		{
+			Branches:              &[]string{"+refs/heads/main"},
+			Artifacts:             &[]string{"build.SourceLabel"},
+			ArtifactTypesToDelete: &[]string{"FilePath", "SymbolStore"},
+			DaysToKeep:            converter.Int(30),
+			MinimumToKeep:         converter.Int(5),
+			DeleteBuildRecord:     converter.Bool(true),
+			DeleteTestResults:     converter.Bool(true),
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	resourceData.SetId(fmt.Sprintf("%d", *buildDefinition.Id))
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+	buildDefinitionAfterRoundTrip, _, err := expandBuildDefinition(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, buildDefinition, *buildDefinitionAfterRoundTrip)
+}
+
+// verifies that options which are not configured are not sent, so that the defaults of the service are used
+func TestBuildDefinition_Expand_OmitsUnconfiguredOptions(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, map[string]interface{}{
+		"project_id": testProjectID,
+		"name":       "Name",
+		"repository": []interface{}{map[string]interface{}{
+			"repo_type": "TfsGit",
+			"repo_id":   "RepoId",
+			"yml_path":  "azure-pipelines.yml",
+		}},
+	})
+	buildDefinition, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Nil(t, buildDefinition.BuildNumberFormat)
+	require.Nil(t, buildDefinition.JobTimeoutInMinutes)
+	require.Nil(t, buildDefinition.JobCancelTimeoutInMinutes)
+	require.Nil(t, buildDefinition.JobAuthorizationScope)
+
+	resourceData = getBuildDefinitionResourceDataWithRawConfig(t, map[string]string{
+		"project_id":                           testProjectID,
+		"name":                                 "Name",
+		"repository.#":                         "1",
+		"repository.0.repo_type":               "TfsGit",
+		"repository.0.repo_id":                 "RepoId",
+		"repository.0.yml_path":                "azure-pipelines.yml",
+		"job_timeout_in_minutes":               "0",
+		"job_authorization_scope":              "project",
+		"retention_rule.#":                     "1",
+		"retention_rule.0.days_to_keep":        "30",
+		"retention_rule.0.delete_build_record": "false",
+	})
+	buildDefinition, _, err = expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, 0, *buildDefinition.JobTimeoutInMinutes)
+	require.Equal(t, build.BuildAuthorizationScopeValues.Project, *buildDefinition.JobAuthorizationScope)
+	require.Nil(t, buildDefinition.JobCancelTimeoutInMinutes)
+	require.Equal(t, []build.RetentionPolicy{{
+		Branches:              &[]string{"+refs/heads/*"},
+		Artifacts:             &[]string{},
+		ArtifactTypesToDelete: &[]string{},
+		DaysToKeep:            converter.Int(30),
+		DeleteBuildRecord:     converter.Bool(false),
+	}}, *buildDefinition.RetentionRules)
+}
+
+// getBuildDefinitionResourceDataWithRawConfig returns the resource data of the configured attributes including the raw
+// configuration, which is not set by schema.TestResourceDataRaw
+func getBuildDefinitionResourceDataWithRawConfig(t *testing.T, attributes map[string]string) *schema.ResourceData {
+	r := ResourceBuildDefinition()
+	state := &terraform.InstanceState{Attributes: attributes}
+	rawConfig, err := state.AttrsAsObjectValue(r.CoreConfigSchema().ImpliedType())
+	require.Nil(t, err)
+	state.RawConfig = rawConfig
+	return r.Data(state)
+}
+
+// verifies that the build number format and the job timeouts of a YAML pipeline, which are defined by the YAML file
+// and not returned by the service, keep their configured values while values returned by the service are read back
+func TestBuildDefinition_Flatten_KeepsOptionsNotReturnedByService(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, map[string]interface{}{
+		"build_number_format":           "$(Build.DefinitionName)_$(rev:r)",
+		"job_timeout_in_minutes":        30,
+		"job_cancel_timeout_in_minutes": 10,
+	})
+	resourceData.SetId(fmt.Sprintf("%d", *testBuildDefinition.Id))
+
+	buildDefinition := testBuildDefinition
+	buildDefinition.BuildNumberFormat = nil
+	buildDefinition.JobTimeoutInMinutes = nil
+	buildDefinition.JobCancelTimeoutInMinutes = nil
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+	require.Equal(t, "$(Build.DefinitionName)_$(rev:r)", resourceData.Get("build_number_format"))
+	require.Equal(t, 30, resourceData.Get("job_timeout_in_minutes"))
+	require.Equal(t, 10, resourceData.Get("job_cancel_timeout_in_minutes"))
+
+	buildDefinition.BuildNumberFormat = converter.String("$(rev:r)")
+	buildDefinition.JobTimeoutInMinutes = converter.Int(60)
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+	require.Equal(t, "$(rev:r)", resourceData.Get("build_number_format"))
+	require.Equal(t, 60, resourceData.Get("job_timeout_in_minutes"))
+	require.Equal(t, 10, resourceData.Get("job_cancel_timeout_in_minutes"))
+}
+
 func sortBuildDefinition(b build.BuildDefinition) build.BuildDefinition {
 	if b.Triggers == nil {
 		return b
@@ -0,0 +1,268 @@
+package build
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelines"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourcePipelineRun schema and implementation for pipeline run resource
+func ResourcePipelineRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipelineRunCreate,
+		Read:   resourcePipelineRunRead,
+		Delete: resourcePipelineRunDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"pipeline_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"template_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"stages_to_skip": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePipelineRunCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	pipelineID := d.Get("pipeline_id").(int)
+	run, err := clients.PipelinesClient.RunPipeline(clients.Ctx, pipelines.RunPipelineArgs{
+		Project:       converter.String(projectID),
+		PipelineId:    converter.Int(pipelineID),
+		RunParameters: expandPipelineRunParameters(d),
+	})
+	if err != nil {
+		return fmt.Errorf(" running pipeline %d: %+v", pipelineID, err)
+	}
+
+	d.SetId(strconv.Itoa(*run.Id))
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				string(pipelines.RunStateValues.Unknown),
+				string(pipelines.RunStateValues.InProgress),
+				string(pipelines.RunStateValues.Canceling),
+			},
+			Target:     []string{string(pipelines.RunStateValues.Completed)},
+			Refresh:    getPipelineRunState(clients, projectID, pipelineID, *run.Id),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 10 * time.Second,
+		}
+
+		completedRun, err := stateConf.WaitForStateContext(clients.Ctx)
+		if err != nil {
+			return fmt.Errorf(" waiting for pipeline run %d to complete: %+v", *run.Id, err)
+		}
+
+		run = completedRun.(*pipelines.Run)
+		flattenPipelineRun(d, run)
+		if run.Result == nil || *run.Result != pipelines.RunResultValues.Succeeded {
+			return fmt.Errorf(" pipeline run %d completed with result %q, see %s", *run.Id, converter.ToString((*string)(run.Result), ""), getPipelineRunWebURL(run))
+		}
+	}
+
+	return resourcePipelineRunRead(d, m)
+}
+
+func resourcePipelineRunRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	runID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing pipeline run ID: %+v", err)
+	}
+
+	run, err := clients.PipelinesClient.GetRun(clients.Ctx, pipelines.GetRunArgs{
+		Project:    converter.String(d.Get("project_id").(string)),
+		PipelineId: converter.Int(d.Get("pipeline_id").(int)),
+		RunId:      converter.Int(runID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading pipeline run %d: %+v", runID, err)
+	}
+
+	flattenPipelineRun(d, run)
+	return nil
+}
+
+// A pipeline run can not be deleted, its retention is managed by the retention policies of the project.
+func resourcePipelineRunDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func expandPipelineRunParameters(d *schema.ResourceData) *pipelines.RunPipelineParameters {
+	runParameters := &pipelines.RunPipelineParameters{}
+
+	if branch, ok := d.GetOk("branch"); ok {
+		refName := branch.(string)
+		if !strings.HasPrefix(refName, "refs/") {
+			refName = "refs/heads/" + refName
+		}
+		runParameters.Resources = &pipelines.RunResourcesParameters{
+			Repositories: &map[string]pipelines.RepositoryResourceParameters{
+				"self": {
+					RefName: converter.String(refName),
+				},
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("template_parameters"); ok {
+		templateParameters := map[string]string{}
+		for key, value := range v.(map[string]interface{}) {
+			templateParameters[key] = value.(string)
+		}
+		runParameters.TemplateParameters = &templateParameters
+	}
+
+	if v, ok := d.GetOk("variables"); ok {
+		variables := map[string]pipelines.Variable{}
+		for key, value := range v.(map[string]interface{}) {
+			variables[key] = pipelines.Variable{
+				Value: converter.String(value.(string)),
+			}
+		}
+		runParameters.Variables = &variables
+	}
+
+	if v, ok := d.GetOk("stages_to_skip"); ok {
+		stagesToSkip := []string{}
+		for _, stage := range v.(*schema.Set).List() {
+			stagesToSkip = append(stagesToSkip, stage.(string))
+		}
+		runParameters.StagesToSkip = &stagesToSkip
+	}
+
+	return runParameters
+}
+
+func flattenPipelineRun(d *schema.ResourceData, run *pipelines.Run) {
+	d.Set("name", converter.ToString(run.Name, ""))
+	if run.State != nil {
+		d.Set("state", string(*run.State))
+	}
+	if run.Result != nil {
+		d.Set("result", string(*run.Result))
+	}
+	d.Set("url", getPipelineRunWebURL(run))
+	if run.CreatedDate != nil {
+		d.Set("created_date", run.CreatedDate.Time.Format(time.RFC3339))
+	}
+	if run.FinishedDate != nil {
+		d.Set("finished_date", run.FinishedDate.Time.Format(time.RFC3339))
+	}
+}
+
+// getPipelineRunWebURL returns the link to the run in the web UI, falling back to the REST API URL of the run
+func getPipelineRunWebURL(run *pipelines.Run) string {
+	if links, ok := run.Links.(map[string]interface{}); ok {
+		if web, ok := links["web"].(map[string]interface{}); ok {
+			if href, ok := web["href"].(string); ok {
+				return href
+			}
+		}
+	}
+	return converter.ToString(run.Url, "")
+}
+
+func getPipelineRunState(clients *client.AggregatedClient, projectID string, pipelineID, runID int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		run, err := clients.PipelinesClient.GetRun(clients.Ctx, pipelines.GetRunArgs{
+			Project:    converter.String(projectID),
+			PipelineId: converter.Int(pipelineID),
+			RunId:      converter.Int(runID),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		if run.State == nil {
+			return run, string(pipelines.RunStateValues.Unknown), nil
+		}
+		return run, string(*run.State), nil
+	}
+}
@@ -0,0 +1,128 @@
+//go:build (all || resource_pipeline_run) && !exclude_resource_pipeline_run
+// +build all resource_pipeline_run
+// +build !exclude_resource_pipeline_run
+
+package build
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelines"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+const testPipelineRunProjectID = "00000000-0000-0000-0000-000000000001"
+
+func getPipelineRunResourceData(t *testing.T, waitForCompletion bool) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourcePipelineRun().Schema, map[string]interface{}{
+		"project_id":          testPipelineRunProjectID,
+		"pipeline_id":         10,
+		"branch":              "main",
+		"template_parameters": map[string]interface{}{"environment": "dev"},
+		"variables":           map[string]interface{}{"verbose": "true"},
+		"stages_to_skip":      []interface{}{"Deploy"},
+		"wait_for_completion": waitForCompletion,
+	})
+}
+
+func TestPipelineRun_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockPipelinesClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesClient: mockClient, Ctx: context.Background()}
+
+	expectedArgs := pipelines.RunPipelineArgs{
+		Project:    converter.String(testPipelineRunProjectID),
+		PipelineId: converter.Int(10),
+		RunParameters: &pipelines.RunPipelineParameters{
+			Resources: &pipelines.RunResourcesParameters{
+				Repositories: &map[string]pipelines.RepositoryResourceParameters{
+					"self": {RefName: converter.String("refs/heads/main")},
+				},
+			},
+			TemplateParameters: &map[string]string{"environment": "dev"},
+			Variables:          &map[string]pipelines.Variable{"verbose": {Value: converter.String("true")}},
+			StagesToSkip:       &[]string{"Deploy"},
+		},
+	}
+	mockClient.
+		EXPECT().
+		RunPipeline(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("RunPipeline() Failed")).
+		Times(1)
+
+	err := ResourcePipelineRun().Create(getPipelineRunResourceData(t, false), clients)
+	require.Contains(t, err.Error(), "RunPipeline() Failed")
+}
+
+func TestPipelineRun_Create_FailsOnFailedResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockPipelinesClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesClient: mockClient, Ctx: context.Background()}
+
+	inProgress := pipelines.RunStateValues.InProgress
+	completed := pipelines.RunStateValues.Completed
+	failed := pipelines.RunResultValues.Failed
+	mockClient.
+		EXPECT().
+		RunPipeline(clients.Ctx, gomock.Any()).
+		Return(&pipelines.Run{Id: converter.Int(5), State: &inProgress}, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		GetRun(clients.Ctx, pipelines.GetRunArgs{
+			Project:    converter.String(testPipelineRunProjectID),
+			PipelineId: converter.Int(10),
+			RunId:      converter.Int(5),
+		}).
+		Return(&pipelines.Run{
+			Id:     converter.Int(5),
+			Name:   converter.String("20240101.1"),
+			State:  &completed,
+			Result: &failed,
+			Links: map[string]interface{}{
+				"web": map[string]interface{}{"href": "https://dev.azure.com/org/project/_build/results?buildId=5"},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := getPipelineRunResourceData(t, true)
+	err := ResourcePipelineRun().Create(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `completed with result "failed"`)
+	require.Equal(t, "5", resourceData.Id())
+	require.Equal(t, "failed", resourceData.Get("result"))
+	require.Equal(t, "https://dev.azure.com/org/project/_build/results?buildId=5", resourceData.Get("url"))
+}
+
+func TestPipelineRun_Read_RemovesDeletedRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockPipelinesClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetRun(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := getPipelineRunResourceData(t, false)
+	resourceData.SetId("5")
+	err := ResourcePipelineRun().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
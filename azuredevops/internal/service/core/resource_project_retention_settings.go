@@ -0,0 +1,311 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/releaseextras"
+)
+
+// ResourceProjectRetentionSettings schema and implementation for project pipeline and release retention settings resource
+func ResourceProjectRetentionSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectRetentionSettingsCreateUpdate,
+		ReadContext:   resourceProjectRetentionSettingsRead,
+		UpdateContext: resourceProjectRetentionSettingsCreateUpdate,
+		DeleteContext: resourceProjectRetentionSettingsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"days_to_keep_runs": {
+				Description:  "Days to keep runs",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"runs_to_retain_per_protected_branch": {
+				Description: "Number of recent runs to retain per pipeline and protected branch",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"days_to_keep_artifacts": {
+				Description:  "Days to keep artifacts, symbols and attachments",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"days_to_keep_pull_request_runs": {
+				Description:  "Days to keep pull request runs",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"days_to_keep_deleted_releases": {
+				Description:  "Days to keep deleted releases",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"default_release_retention_policy": {
+				Description: "Default retention policy of the stages of new release definitions",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_to_keep": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"releases_to_keep": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"retain_build": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"maximum_release_retention_policy": {
+				Description: "Maximum retention policy of the stages of release definitions",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_to_keep": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"releases_to_keep": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectRetentionSettingsCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	err := configureProjectRetentionSettings(ctx, clients, projectID, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" creating/updating project retention settings: %v", err))
+	}
+
+	err = configureProjectReleaseRetentionSettings(ctx, clients, projectID, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" creating/updating project release retention settings: %v", err))
+	}
+	d.SetId(projectID)
+	return resourceProjectRetentionSettingsRead(ctx, d, m)
+}
+
+func resourceProjectRetentionSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Id()
+	settings, err := clients.BuildClient.GetRetentionSettings(ctx, build.GetRetentionSettingsArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(" reading project retention settings: %v", err))
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("days_to_keep_runs", getRetentionSettingValue(settings.PurgeRuns))
+	d.Set("runs_to_retain_per_protected_branch", getRetentionSettingValue(settings.RetainRunsPerProtectedBranch))
+	d.Set("days_to_keep_artifacts", getRetentionSettingValue(settings.PurgeArtifacts))
+	d.Set("days_to_keep_pull_request_runs", getRetentionSettingValue(settings.PurgePullRequestRuns))
+
+	releaseSettings, err := clients.ReleaseClientExtras.GetReleaseSettings(ctx, releaseextras.GetReleaseSettingsArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" reading project release retention settings: %v", err))
+	}
+	if retention := releaseSettings.RetentionSettings; retention != nil {
+		d.Set("days_to_keep_deleted_releases", converter.ToInt(retention.DaysToKeepDeletedReleases, 0))
+		d.Set("default_release_retention_policy", flattenReleaseRetentionPolicy(retention.DefaultEnvironmentRetentionPolicy, true))
+		d.Set("maximum_release_retention_policy", flattenReleaseRetentionPolicy(retention.MaximumEnvironmentRetentionPolicy, false))
+	}
+	return nil
+}
+
+func resourceProjectRetentionSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// nothing to do, as the original settings are unknown.
+	return nil
+}
+
+func configureProjectRetentionSettings(ctx context.Context, clients *client.AggregatedClient, projectID string, d *schema.ResourceData) error {
+	// the current settings contain the limits which are allowed for the organization
+	current, err := clients.BuildClient.GetRetentionSettings(ctx, build.GetRetentionSettingsArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return err
+	}
+
+	updateModel := &build.UpdateProjectRetentionSettingModel{}
+	if updateModel.RunRetention, err = expandRetentionSetting(d, "days_to_keep_runs", current.PurgeRuns); err != nil {
+		return err
+	}
+	if updateModel.RetainRunsPerProtectedBranch, err = expandRetentionSetting(d, "runs_to_retain_per_protected_branch", current.RetainRunsPerProtectedBranch); err != nil {
+		return err
+	}
+	if updateModel.ArtifactsRetention, err = expandRetentionSetting(d, "days_to_keep_artifacts", current.PurgeArtifacts); err != nil {
+		return err
+	}
+	if updateModel.PullRequestRunRetention, err = expandRetentionSetting(d, "days_to_keep_pull_request_runs", current.PurgePullRequestRuns); err != nil {
+		return err
+	}
+
+	_, err = clients.BuildClient.UpdateRetentionSettings(ctx, build.UpdateRetentionSettingsArgs{
+		Project:     converter.String(projectID),
+		UpdateModel: updateModel,
+	})
+	return err
+}
+
+// configureProjectReleaseRetentionSettings updates the release retention settings if any of them is configured. The
+// settings which are not configured keep their current value.
+func configureProjectReleaseRetentionSettings(ctx context.Context, clients *client.AggregatedClient, projectID string, d *schema.ResourceData) error {
+	daysToKeepDeletedReleases, hasDaysToKeepDeletedReleases := d.GetOk("days_to_keep_deleted_releases")
+	defaultPolicy, hasDefaultPolicy := d.GetOk("default_release_retention_policy")
+	maximumPolicy, hasMaximumPolicy := d.GetOk("maximum_release_retention_policy")
+	if !hasDaysToKeepDeletedReleases && !hasDefaultPolicy && !hasMaximumPolicy {
+		return nil
+	}
+
+	current, err := clients.ReleaseClientExtras.GetReleaseSettings(ctx, releaseextras.GetReleaseSettingsArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return err
+	}
+
+	retention := &release.RetentionSettings{}
+	if current.RetentionSettings != nil {
+		retention = current.RetentionSettings
+	}
+	if hasDaysToKeepDeletedReleases {
+		retention.DaysToKeepDeletedReleases = converter.Int(daysToKeepDeletedReleases.(int))
+	}
+	if hasDefaultPolicy {
+		retention.DefaultEnvironmentRetentionPolicy = expandReleaseRetentionPolicy(defaultPolicy.([]interface{}))
+	}
+	if hasMaximumPolicy {
+		retention.MaximumEnvironmentRetentionPolicy = expandReleaseRetentionPolicy(maximumPolicy.([]interface{}))
+	}
+
+	_, err = clients.ReleaseClientExtras.UpdateReleaseSettings(ctx, releaseextras.UpdateReleaseSettingsArgs{
+		Project: converter.String(projectID),
+		ReleaseSettings: &release.ReleaseSettings{
+			ComplianceSettings: current.ComplianceSettings,
+			RetentionSettings:  retention,
+		},
+	})
+	return err
+}
+
+func expandReleaseRetentionPolicy(policies []interface{}) *release.EnvironmentRetentionPolicy {
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+	policy := policies[0].(map[string]interface{})
+	result := &release.EnvironmentRetentionPolicy{
+		DaysToKeep:     converter.Int(policy["days_to_keep"].(int)),
+		ReleasesToKeep: converter.Int(policy["releases_to_keep"].(int)),
+	}
+	if retainBuild, ok := policy["retain_build"]; ok {
+		result.RetainBuild = converter.Bool(retainBuild.(bool))
+	}
+	return result
+}
+
+func flattenReleaseRetentionPolicy(policy *release.EnvironmentRetentionPolicy, withRetainBuild bool) []interface{} {
+	if policy == nil {
+		return nil
+	}
+	result := map[string]interface{}{
+		"days_to_keep":     converter.ToInt(policy.DaysToKeep, 0),
+		"releases_to_keep": converter.ToInt(policy.ReleasesToKeep, 0),
+	}
+	if withRetainBuild {
+		result["retain_build"] = converter.ToBool(policy.RetainBuild, false)
+	}
+	return []interface{}{result}
+}
+
+// expandRetentionSetting returns the update model of a configured setting, nil if the setting is not configured. The
+// limits of the organization are checked here, as `0` is a valid value for some settings.
+func expandRetentionSetting(d *schema.ResourceData, key string, current *build.RetentionSetting) (*build.UpdateRetentionSettingModel, error) {
+	v, ok := d.GetOkExists(key) //nolint:staticcheck
+	if !ok {
+		return nil, nil
+	}
+
+	value := v.(int)
+	if current != nil {
+		if current.Min != nil && value < *current.Min {
+			return nil, fmt.Errorf(" %s must be at least %d, got %d", key, *current.Min, value)
+		}
+		if current.Max != nil && value > *current.Max {
+			return nil, fmt.Errorf(" %s must be at most %d, got %d", key, *current.Max, value)
+		}
+	}
+	return &build.UpdateRetentionSettingModel{
+		Value: converter.Int(value),
+	}, nil
+}
+
+func getRetentionSettingValue(setting *build.RetentionSetting) int {
+	if setting == nil || setting.Value == nil {
+		return 0
+	}
+	return *setting.Value
+}
@@ -0,0 +1,244 @@
+//go:build (all || core || resource_project_retention_settings) && !exclude_resource_project_retention_settings
+// +build all core resource_project_retention_settings
+// +build !exclude_resource_project_retention_settings
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/releaseextras"
+	"github.com/stretchr/testify/require"
+)
+
+const testRetentionSettingsProjectID = "00000000-0000-0000-0000-000000000001"
+
+var testRetentionSettings = &build.ProjectRetentionSetting{
+	PurgeRuns:                    &build.RetentionSetting{Min: converter.Int(30), Max: converter.Int(731), Value: converter.Int(30)},
+	RetainRunsPerProtectedBranch: &build.RetentionSetting{Min: converter.Int(0), Max: converter.Int(50), Value: converter.Int(3)},
+	PurgeArtifacts:               &build.RetentionSetting{Min: converter.Int(1), Max: converter.Int(60), Value: converter.Int(30)},
+	PurgePullRequestRuns:         &build.RetentionSetting{Min: converter.Int(1), Max: converter.Int(30), Value: converter.Int(10)},
+}
+
+func testReleaseSettings() *release.ReleaseSettings {
+	return &release.ReleaseSettings{
+		ComplianceSettings: &release.ComplianceSettings{CheckForCredentialsAndOtherSecrets: converter.Bool(true)},
+		RetentionSettings: &release.RetentionSettings{
+			DaysToKeepDeletedReleases: converter.Int(14),
+			DefaultEnvironmentRetentionPolicy: &release.EnvironmentRetentionPolicy{
+				DaysToKeep:     converter.Int(30),
+				ReleasesToKeep: converter.Int(3),
+				RetainBuild:    converter.Bool(true),
+			},
+			MaximumEnvironmentRetentionPolicy: &release.EnvironmentRetentionPolicy{
+				DaysToKeep:     converter.Int(365),
+				ReleasesToKeep: converter.Int(25),
+			},
+		},
+	}
+}
+
+func TestProjectRetentionSettings_Create_UpdatesConfiguredSettingsOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	releaseClient := azdosdkmocks.NewMockReleaseextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, ReleaseClientExtras: releaseClient, Ctx: context.Background()}
+
+	getArgs := build.GetRetentionSettingsArgs{Project: converter.String(testRetentionSettingsProjectID)}
+	buildClient.
+		EXPECT().
+		GetRetentionSettings(gomock.Any(), getArgs).
+		Return(testRetentionSettings, nil).
+		Times(2)
+	buildClient.
+		EXPECT().
+		UpdateRetentionSettings(gomock.Any(), build.UpdateRetentionSettingsArgs{
+			Project: converter.String(testRetentionSettingsProjectID),
+			UpdateModel: &build.UpdateProjectRetentionSettingModel{
+				RunRetention:            &build.UpdateRetentionSettingModel{Value: converter.Int(60)},
+				PullRequestRunRetention: &build.UpdateRetentionSettingModel{Value: converter.Int(5)},
+			},
+		}).
+		Return(testRetentionSettings, nil).
+		Times(1)
+
+	releaseClient.
+		EXPECT().
+		GetReleaseSettings(gomock.Any(), gomock.Any()).
+		Return(testReleaseSettings(), nil).
+		Times(1)
+	releaseClient.
+		EXPECT().
+		UpdateReleaseSettings(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, map[string]interface{}{
+		"project_id":                     testRetentionSettingsProjectID,
+		"days_to_keep_runs":              60,
+		"days_to_keep_pull_request_runs": 5,
+	})
+	diags := resourceProjectRetentionSettingsCreateUpdate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, testRetentionSettingsProjectID, resourceData.Id())
+	require.Equal(t, 3, resourceData.Get("runs_to_retain_per_protected_branch"))
+	require.Equal(t, 30, resourceData.Get("days_to_keep_artifacts"))
+	require.Equal(t, 14, resourceData.Get("days_to_keep_deleted_releases"))
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"days_to_keep":     365,
+		"releases_to_keep": 25,
+	}}, resourceData.Get("maximum_release_retention_policy"))
+}
+
+func TestProjectRetentionSettings_Create_UpdatesConfiguredReleaseSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	releaseClient := azdosdkmocks.NewMockReleaseextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, ReleaseClientExtras: releaseClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetRetentionSettings(gomock.Any(), gomock.Any()).
+		Return(testRetentionSettings, nil).
+		Times(2)
+	buildClient.
+		EXPECT().
+		UpdateRetentionSettings(gomock.Any(), gomock.Any()).
+		Return(testRetentionSettings, nil).
+		Times(1)
+
+	getArgs := releaseextras.GetReleaseSettingsArgs{Project: converter.String(testRetentionSettingsProjectID)}
+	releaseClient.
+		EXPECT().
+		GetReleaseSettings(gomock.Any(), getArgs).
+		Return(testReleaseSettings(), nil).
+		Times(2)
+
+	expectedSettings := testReleaseSettings()
+	expectedSettings.RetentionSettings.DefaultEnvironmentRetentionPolicy = &release.EnvironmentRetentionPolicy{
+		DaysToKeep:     converter.Int(60),
+		ReleasesToKeep: converter.Int(5),
+		RetainBuild:    converter.Bool(false),
+	}
+	releaseClient.
+		EXPECT().
+		UpdateReleaseSettings(gomock.Any(), releaseextras.UpdateReleaseSettingsArgs{
+			Project:         converter.String(testRetentionSettingsProjectID),
+			ReleaseSettings: expectedSettings,
+		}).
+		Return(expectedSettings, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, map[string]interface{}{
+		"project_id": testRetentionSettingsProjectID,
+		"default_release_retention_policy": []interface{}{map[string]interface{}{
+			"days_to_keep":     60,
+			"releases_to_keep": 5,
+			"retain_build":     false,
+		}},
+	})
+	diags := resourceProjectRetentionSettingsCreateUpdate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+}
+
+func TestProjectRetentionSettings_Create_UpdatesZeroValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	releaseClient := azdosdkmocks.NewMockReleaseextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, ReleaseClientExtras: releaseClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetRetentionSettings(gomock.Any(), gomock.Any()).
+		Return(testRetentionSettings, nil).
+		Times(2)
+	buildClient.
+		EXPECT().
+		UpdateRetentionSettings(gomock.Any(), build.UpdateRetentionSettingsArgs{
+			Project: converter.String(testRetentionSettingsProjectID),
+			UpdateModel: &build.UpdateProjectRetentionSettingModel{
+				RetainRunsPerProtectedBranch: &build.UpdateRetentionSettingModel{Value: converter.Int(0)},
+			},
+		}).
+		Return(testRetentionSettings, nil).
+		Times(1)
+
+	releaseClient.
+		EXPECT().
+		GetReleaseSettings(gomock.Any(), gomock.Any()).
+		Return(testReleaseSettings(), nil).
+		Times(1)
+	releaseClient.
+		EXPECT().
+		UpdateReleaseSettings(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, map[string]interface{}{
+		"project_id":                          testRetentionSettingsProjectID,
+		"runs_to_retain_per_protected_branch": 0,
+	})
+	diags := resourceProjectRetentionSettingsCreateUpdate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+}
+
+func TestProjectRetentionSettings_Create_ValidatesOrganizationLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	releaseClient := azdosdkmocks.NewMockReleaseextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, ReleaseClientExtras: releaseClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetRetentionSettings(gomock.Any(), gomock.Any()).
+		Return(testRetentionSettings, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateRetentionSettings(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, map[string]interface{}{
+		"project_id":             testRetentionSettingsProjectID,
+		"days_to_keep_artifacts": 90,
+	})
+	diags := resourceProjectRetentionSettingsCreateUpdate(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "days_to_keep_artifacts must be at most 60, got 90")
+}
+
+func TestProjectRetentionSettings_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	releaseClient := azdosdkmocks.NewMockReleaseextrasClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, ReleaseClientExtras: releaseClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetRetentionSettings(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("GetRetentionSettings() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, nil)
+	resourceData.SetId(testRetentionSettingsProjectID)
+	diags := resourceProjectRetentionSettingsRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetRetentionSettings() Failed")
+}
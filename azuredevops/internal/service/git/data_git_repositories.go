@@ -42,6 +42,26 @@ func DataGitRepositories() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"include_branches": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_statistics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude_empty": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"repositories": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -87,6 +107,54 @@ func DataGitRepositories() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"is_fork": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"parent_repository_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_repository_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_branch_commit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_push_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"branches": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"commit_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ahead_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"behind_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -109,11 +177,29 @@ func dataSourceGitRepositoriesRead(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf(" finding repositories. Error: %v", err)
 	}
 
+	projectRepos = filterGitRepositories(projectRepos, d.Get("exclude_disabled").(bool), d.Get("exclude_empty").(bool))
+
+	err = resolveGitRepositoryParents(clients, projectRepos)
+	if err != nil {
+		return fmt.Errorf(" finding parent repositories of forks. Error: %v", err)
+	}
+
 	results, err := flattenGitRepositories(projectRepos)
 	if err != nil {
 		return fmt.Errorf(" flattening projects. Error: %v", err)
 	}
 
+	includeBranches := d.Get("include_branches").(bool)
+	includeStatistics := d.Get("include_statistics").(bool)
+	if (includeBranches || includeStatistics) && projectRepos != nil {
+		for i, repo := range *projectRepos {
+			err = flattenGitRepositoryStatistics(clients, &repo, includeBranches, includeStatistics, results[i].(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf(" reading statistics of repository %s. Error: %v", converter.ToString(repo.Name, ""), err)
+			}
+		}
+	}
+
 	repoNames, err := datahelper.GetAttributeValues(results, "name")
 	if err != nil {
 		return fmt.Errorf(" failed to get list of repository names: %v", err)
@@ -146,6 +232,12 @@ func createGitRepositoryDataSourceID(d *schema.ResourceData, repoNames *[]string
 	if projectID != "" {
 		names = append([]string{projectID}, names...)
 	}
+	// the filters and the requested details change the result, so they are part of the ID as well
+	for _, key := range []string{"include_hidden", "include_branches", "include_statistics", "exclude_disabled", "exclude_empty"} {
+		if d.Get(key).(bool) {
+			names = append(names, key)
+		}
+	}
 	if _, err := h.Write([]byte(strings.Join(names, "-"))); err != nil {
 		return "", fmt.Errorf(" Unable to compute hash for Git repository names: %v", err)
 	}
@@ -201,12 +293,151 @@ func flattenGitRepositories(repos *[]git.GitRepository) ([]interface{}, error) {
 			output["disabled"] = *element.IsDisabled
 		}
 
+		if element.IsFork != nil {
+			output["is_fork"] = *element.IsFork
+		}
+
+		for k, v := range flattenGitRepositoryParent(element.ParentRepository) {
+			output[k] = v
+		}
+
 		results = append(results, output)
 	}
 
 	return results, nil
 }
 
+// filterGitRepositories removes disabled repositories and repositories without any branch if requested
+func filterGitRepositories(repos *[]git.GitRepository, excludeDisabled bool, excludeEmpty bool) *[]git.GitRepository {
+	if repos == nil || (!excludeDisabled && !excludeEmpty) {
+		return repos
+	}
+	filtered := make([]git.GitRepository, 0, len(*repos))
+	for _, repo := range *repos {
+		if excludeDisabled && converter.ToBool(repo.IsDisabled, false) {
+			continue
+		}
+		if excludeEmpty && converter.ToString(repo.DefaultBranch, "") == "" {
+			continue
+		}
+		filtered = append(filtered, repo)
+	}
+	return &filtered
+}
+
+// flattenGitRepositoryStatistics adds the branches and statistics of the repository to the output. Disabled
+// and empty repositories are skipped as there is no data available for them.
+func flattenGitRepositoryStatistics(clients *client.AggregatedClient, repo *git.GitRepository, includeBranches bool, includeStatistics bool, output map[string]interface{}) error {
+	defaultBranch := converter.ToString(repo.DefaultBranch, "")
+	if converter.ToBool(repo.IsDisabled, false) || defaultBranch == "" || repo.Id == nil {
+		return nil
+	}
+	repoID := repo.Id.String()
+	baseVersion := &git.GitVersionDescriptor{
+		Version:     converter.String(strings.TrimPrefix(defaultBranch, REF_BRANCH_PREFIX)),
+		VersionType: &git.GitVersionTypeValues.Branch,
+	}
+
+	if includeBranches {
+		branches, err := clients.GitReposClient.GetBranches(clients.Ctx, git.GetBranchesArgs{
+			RepositoryId:          converter.String(repoID),
+			BaseVersionDescriptor: baseVersion,
+		})
+		if err != nil {
+			return err
+		}
+		output["branches"] = flattenGitBranchStats(branches)
+	}
+
+	if includeStatistics {
+		branch, err := clients.GitReposClient.GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(repoID),
+			Name:         baseVersion.Version,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return err
+		}
+		if branch != nil && branch.Commit != nil {
+			output["default_branch_commit_id"] = converter.ToString(branch.Commit.CommitId, "")
+		}
+
+		pushes, err := clients.GitReposClient.GetPushes(clients.Ctx, git.GetPushesArgs{
+			RepositoryId: converter.String(repoID),
+			Top:          converter.Int(1),
+		})
+		if err != nil {
+			return err
+		}
+		if pushes != nil && len(*pushes) > 0 && (*pushes)[0].Date != nil {
+			output["last_push_date"] = (*pushes)[0].Date.Time.Format(time.RFC3339)
+		}
+	}
+	return nil
+}
+
+func flattenGitBranchStats(branches *[]git.GitBranchStats) []interface{} {
+	if branches == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*branches))
+	for _, branch := range *branches {
+		output := map[string]interface{}{
+			"name": converter.ToString(branch.Name, ""),
+		}
+		if branch.Commit != nil {
+			output["commit_id"] = converter.ToString(branch.Commit.CommitId, "")
+		}
+		if branch.AheadCount != nil {
+			output["ahead_count"] = *branch.AheadCount
+		}
+		if branch.BehindCount != nil {
+			output["behind_count"] = *branch.BehindCount
+		}
+		results = append(results, output)
+	}
+	return results
+}
+
+// resolveGitRepositoryParents looks up the parent repository of forks for which the repository list does not contain
+// the parent repository. Parent repositories which are already known are reused without further requests.
+func resolveGitRepositoryParents(clients *client.AggregatedClient, repos *[]git.GitRepository) error {
+	if repos == nil {
+		return nil
+	}
+	for i, repo := range *repos {
+		if !converter.ToBool(repo.IsFork, false) || repo.ParentRepository != nil || repo.Id == nil {
+			continue
+		}
+		fork, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(repo.Id.String()),
+		})
+		if err != nil {
+			return err
+		}
+		(*repos)[i].ParentRepository = fork.ParentRepository
+	}
+	return nil
+}
+
+func flattenGitRepositoryParent(parent *git.GitRepositoryRef) map[string]interface{} {
+	output := map[string]interface{}{
+		"parent_repository_id":   "",
+		"parent_repository_name": "",
+		"parent_project_id":      "",
+	}
+	if parent == nil {
+		return output
+	}
+	if parent.Id != nil {
+		output["parent_repository_id"] = parent.Id.String()
+	}
+	output["parent_repository_name"] = converter.ToString(parent.Name, "")
+	if parent.Project != nil && parent.Project.Id != nil {
+		output["parent_project_id"] = parent.Project.Id.String()
+	}
+	return output
+}
+
 func getGitRepositoriesByNameAndProject(clients *client.AggregatedClient, name string, projectID string, includeHidden bool) (*[]git.GitRepository, error) {
 	var repos *[]git.GitRepository
 	var err error
@@ -7,10 +7,13 @@ package git
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
@@ -151,6 +154,52 @@ func TestGitRepositoriesDataSource_Read_TestHandleErrorWithSpecificRepository(t
 	require.Zero(t, len(repos))
 }
 
+func TestGitRepositoriesDataSource_Read_RepositoryNotFoundWithBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+	repoClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitRepository{gitRepoList[0]}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositories().Schema, map[string]interface{}{
+		"name":             "missing-repo",
+		"project_id":       azProjectRef.Id.String(),
+		"include_branches": true,
+	})
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Get("repositories").([]interface{}))
+}
+
+func TestGitRepositoriesDataSource_DataSourceID_ContainsFilters(t *testing.T) {
+	unfiltered := schema.TestResourceDataRaw(t, DataGitRepositories().Schema, nil)
+	filtered := schema.TestResourceDataRaw(t, DataGitRepositories().Schema, map[string]interface{}{
+		"exclude_empty": true,
+	})
+	names := []string{"repo-01"}
+
+	unfilteredID, err := createGitRepositoryDataSourceID(unfiltered, &names)
+	require.Nil(t, err)
+	filteredID, err := createGitRepositoryDataSourceID(filtered, &names)
+	require.Nil(t, err)
+	require.NotEqual(t, unfilteredID, filteredID)
+}
+
 func TestGitRepositoriesDataSource_Read_NoRepositories(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -299,3 +348,176 @@ func TestGitRepositoriesDataSource_Read_SingleRepository(t *testing.T) {
 	require.NotNil(t, repos)
 	require.Equal(t, len(repos), 1)
 }
+
+func TestGitRepositoriesDataSource_Read_ResolvesParentOfForks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	fork := gitRepoList[1]
+	fork.ParentRepository = nil
+	parent := &git.GitRepositoryRef{
+		Id:      testhelper.CreateUUID(),
+		Name:    converter.String("repo-parent-02"),
+		Project: azProjectRef,
+	}
+
+	repoClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitRepository{gitRepoList[0], fork}, nil).
+		Times(1)
+
+	repoClient.
+		EXPECT().
+		GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(fork.Id.String())}).
+		Return(&git.GitRepository{Id: fork.Id, ParentRepository: parent}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositories().Schema, nil)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	repos := resourceData.Get("repositories").([]interface{})
+	require.Equal(t, 2, len(repos))
+
+	repoMap := make(map[string]map[string]interface{})
+	for _, item := range repos {
+		repoData := item.(map[string]interface{})
+		repoMap[repoData["name"].(string)] = repoData
+	}
+	require.Equal(t, false, repoMap["repo-01"]["is_fork"])
+	require.Equal(t, "", repoMap["repo-01"]["parent_repository_id"])
+	require.Equal(t, true, repoMap["repo-02"]["is_fork"])
+	require.Equal(t, parent.Id.String(), repoMap["repo-02"]["parent_repository_id"])
+	require.Equal(t, "repo-parent-02", repoMap["repo-02"]["parent_repository_name"])
+	require.Equal(t, azProjectRef.Id.String(), repoMap["repo-02"]["parent_project_id"])
+}
+
+func TestGitRepositoriesDataSource_Read_ReusesKnownParentOfForks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitRepository{gitRepoList[0], gitRepoList[1]}, nil).
+		Times(1)
+	repoClient.
+		EXPECT().
+		GetRepository(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositories().Schema, nil)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, gitRepoList[1].ParentRepository.Id.String(), resourceData.Get("repositories.1.parent_repository_id"))
+}
+
+func TestGitRepositoriesDataSource_Read_FiltersAndStatistics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	activeRepo := git.GitRepository{
+		Id:            testhelper.CreateUUID(),
+		Name:          converter.String("active"),
+		DefaultBranch: converter.String("refs/heads/main"),
+		Project:       azProjectRef,
+	}
+	emptyRepo := git.GitRepository{
+		Id:      testhelper.CreateUUID(),
+		Name:    converter.String("empty"),
+		Project: azProjectRef,
+	}
+	disabledRepo := git.GitRepository{
+		Id:            testhelper.CreateUUID(),
+		Name:          converter.String("disabled"),
+		DefaultBranch: converter.String("refs/heads/main"),
+		IsDisabled:    converter.Bool(true),
+		Project:       azProjectRef,
+	}
+
+	repoClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitRepository{activeRepo, emptyRepo, disabledRepo}, nil).
+		Times(1)
+
+	repoClient.
+		EXPECT().
+		GetBranches(clients.Ctx, git.GetBranchesArgs{
+			RepositoryId: converter.String(activeRepo.Id.String()),
+			BaseVersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String("main"),
+				VersionType: &git.GitVersionTypeValues.Branch,
+			},
+		}).
+		Return(&[]git.GitBranchStats{
+			{Name: converter.String("main"), Commit: &git.GitCommitRef{CommitId: converter.String("commit-1")}, AheadCount: converter.Int(0), BehindCount: converter.Int(0), IsBaseVersion: converter.Bool(true)},
+			{Name: converter.String("feature"), Commit: &git.GitCommitRef{CommitId: converter.String("commit-2")}, AheadCount: converter.Int(2), BehindCount: converter.Int(1)},
+		}, nil).
+		Times(1)
+
+	repoClient.
+		EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(activeRepo.Id.String()),
+			Name:         converter.String("main"),
+		}).
+		Return(&git.GitBranchStats{Name: converter.String("main"), Commit: &git.GitCommitRef{CommitId: converter.String("commit-1")}}, nil).
+		Times(1)
+
+	pushDate := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	repoClient.
+		EXPECT().
+		GetPushes(clients.Ctx, git.GetPushesArgs{
+			RepositoryId: converter.String(activeRepo.Id.String()),
+			Top:          converter.Int(1),
+		}).
+		Return(&[]git.GitPush{{Date: &azuredevops.Time{Time: pushDate}}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositories().Schema, map[string]interface{}{
+		"include_branches":   true,
+		"include_statistics": true,
+		"exclude_disabled":   true,
+		"exclude_empty":      true,
+	})
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	repos := resourceData.Get("repositories").([]interface{})
+	require.Equal(t, 1, len(repos))
+
+	repo := repos[0].(map[string]interface{})
+	require.Equal(t, "active", repo["name"])
+	require.Equal(t, "commit-1", repo["default_branch_commit_id"])
+	require.Equal(t, "2024-01-02T03:04:05Z", repo["last_push_date"])
+
+	branches := repo["branches"].([]interface{})
+	require.Equal(t, 2, len(branches))
+	require.Equal(t, map[string]interface{}{
+		"name":         "feature",
+		"commit_id":    "commit-2",
+		"ahead_count":  2,
+		"behind_count": 1,
+	}, branches[1])
+}
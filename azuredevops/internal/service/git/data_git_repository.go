@@ -63,6 +63,18 @@ func DataGitRepository() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"parent_repository_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_repository_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -92,5 +104,10 @@ func dataSourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf(" flattening Git repository: %w", err)
 	}
+
+	parent := flattenGitRepositoryParent((*projectRepos)[0].ParentRepository)
+	d.Set("parent_repository_id", parent["parent_repository_id"])
+	d.Set("parent_repository_name", parent["parent_repository_name"])
+	d.Set("parent_project_id", parent["parent_project_id"])
 	return nil
 }
@@ -0,0 +1,154 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryFile schema and implementation for Git repository file data source
+func DataGitRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGitRepositoryFileRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"branch": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"tag", "commit_id"},
+			},
+			"tag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"branch", "commit_id"},
+			},
+			"commit_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"branch", "tag"},
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+	versionDescriptor := expandGitVersionDescriptor(d)
+
+	repoItem, err := clients.GitReposClient.GetItem(clients.Ctx, git.GetItemArgs{
+		RepositoryId:          &repoId,
+		Path:                  &file,
+		LatestProcessedChange: converter.Bool(true),
+		VersionDescriptor:     versionDescriptor,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return diag.Errorf(" File %s does not exist in repository %s", file, repoId)
+		}
+		return diag.Errorf(" Reading file %s from repository %s: %+v", file, repoId, err)
+	}
+
+	if repoItem.IsFolder != nil && *repoItem.IsFolder {
+		return diag.Errorf(" Path %s in repository %s is a folder, not a file", file, repoId)
+	}
+
+	reader, err := clients.GitReposClient.GetItemContent(clients.Ctx, git.GetItemContentArgs{
+		RepositoryId:      &repoId,
+		Path:              &file,
+		VersionDescriptor: versionDescriptor,
+	})
+	if err != nil {
+		return diag.Errorf(" Reading content of file %s from repository %s: %+v", file, repoId, err)
+	}
+	defer reader.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err = buf.ReadFrom(reader); err != nil {
+		return diag.Errorf(" Reading content of file %s from repository %s: %+v", file, repoId, err)
+	}
+
+	lastCommitId := repoItem.CommitId
+	if repoItem.LatestProcessedChange != nil && repoItem.LatestProcessedChange.CommitId != nil {
+		lastCommitId = repoItem.LatestProcessedChange.CommitId
+	}
+
+	id := fmt.Sprintf("%s/%s", repoId, strings.TrimPrefix(file, "/"))
+	if versionDescriptor != nil {
+		// the same file can be read at several versions, e.g. from two branches
+		id = fmt.Sprintf("%s@%s:%s", id, *versionDescriptor.VersionType, *versionDescriptor.Version)
+	}
+	d.SetId(id)
+	d.Set("content", buf.String())
+	d.Set("object_id", repoItem.ObjectId)
+	d.Set("last_commit_id", lastCommitId)
+	d.Set("size", buf.Len())
+	return nil
+}
+
+// expandGitVersionDescriptor returns the version descriptor for the branch, tag or commit configured.
+// If none is configured, nil is returned and the default branch of the repository is used.
+func expandGitVersionDescriptor(d *schema.ResourceData) *git.GitVersionDescriptor {
+	if v, ok := d.GetOk("commit_id"); ok {
+		return &git.GitVersionDescriptor{
+			Version:     converter.String(v.(string)),
+			VersionType: &git.GitVersionTypeValues.Commit,
+		}
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		return &git.GitVersionDescriptor{
+			Version:     converter.String(strings.TrimPrefix(v.(string), REF_TAG_PREFIX)),
+			VersionType: &git.GitVersionTypeValues.Tag,
+		}
+	}
+	if v, ok := d.GetOk("branch"); ok {
+		return &git.GitVersionDescriptor{
+			Version:     converter.String(shortBranchName(v.(string))),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		}
+	}
+	return nil
+}
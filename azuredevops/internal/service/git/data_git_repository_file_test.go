@@ -0,0 +1,104 @@
+//go:build (all || git || data_sources || data_git_repository_file) && (!exclude_data_sources || !exclude_git || !exclude_data_git_repository_file)
+// +build all git data_sources data_git_repository_file
+// +build !exclude_data_sources !exclude_git !exclude_data_git_repository_file
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRepositoryFileDataSource_Read_DontSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoClient.
+		EXPECT().
+		GetItem(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@GetItem@@failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositoryFile().Schema, nil)
+	resourceData.Set("repository_id", "00000000-0000-0000-0000-000000000001")
+	resourceData.Set("file", "config.yml")
+
+	diags := dataSourceGitRepositoryFileRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "@@GetItem@@failed")
+}
+
+func TestGitRepositoryFileDataSource_Read_Tag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoId := "00000000-0000-0000-0000-000000000001"
+	file := "config.yml"
+	versionDescriptor := &git.GitVersionDescriptor{
+		Version:     converter.String("v1.0.0"),
+		VersionType: &git.GitVersionTypeValues.Tag,
+	}
+
+	repoClient.
+		EXPECT().
+		GetItem(clients.Ctx, git.GetItemArgs{
+			RepositoryId:          &repoId,
+			Path:                  &file,
+			LatestProcessedChange: converter.Bool(true),
+			VersionDescriptor:     versionDescriptor,
+		}).
+		Return(&git.GitItem{
+			ObjectId: converter.String("an-object"),
+			CommitId: converter.String("a-commit"),
+			LatestProcessedChange: &git.GitCommitRef{
+				CommitId: converter.String("a-last-commit"),
+			},
+		}, nil).
+		Times(1)
+
+	repoClient.
+		EXPECT().
+		GetItemContent(clients.Ctx, git.GetItemContentArgs{
+			RepositoryId:      &repoId,
+			Path:              &file,
+			VersionDescriptor: versionDescriptor,
+		}).
+		Return(io.NopCloser(strings.NewReader("foo: bar")), nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositoryFile().Schema, nil)
+	resourceData.Set("repository_id", repoId)
+	resourceData.Set("file", file)
+	resourceData.Set("tag", "refs/tags/v1.0.0")
+
+	diags := dataSourceGitRepositoryFileRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, repoId+"/"+file+"@tag:v1.0.0", resourceData.Id())
+	require.Equal(t, "foo: bar", resourceData.Get("content"))
+	require.Equal(t, "an-object", resourceData.Get("object_id"))
+	require.Equal(t, "a-last-commit", resourceData.Get("last_commit_id"))
+	require.Equal(t, 8, resourceData.Get("size"))
+}
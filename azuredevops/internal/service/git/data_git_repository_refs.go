@@ -0,0 +1,142 @@
+package git
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryRefs schema and implementation for Git repository refs data source
+func DataGitRepositoryRefs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGitRepositoryRefsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"filter_contains": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"refs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peeled_object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_locked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryRefsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	args := git.GetRefsArgs{
+		RepositoryId: converter.String(repoId),
+		PeelTags:     converter.Bool(true),
+	}
+	if v, ok := d.GetOk("filter"); ok {
+		args.Filter = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("filter_contains"); ok {
+		args.FilterContains = converter.String(v.(string))
+	}
+
+	refs, err := getAllRefs(clients, args)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return diag.Errorf(" Repository %s does not exist", repoId)
+		}
+		return diag.Errorf(" Reading refs of repository %s: %+v", repoId, err)
+	}
+
+	id, err := createGitRepositoryRefsDataSourceID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("refs", flattenGitRefs(refs)); err != nil {
+		return diag.Errorf(" setting refs: %+v", err)
+	}
+	return nil
+}
+
+// createGitRepositoryRefsDataSourceID returns an ID for the refs of a repository, which differs per filter.
+func createGitRepositoryRefsDataSourceID(d *schema.ResourceData) (string, error) {
+	h := sha1.New()
+	values := []string{d.Get("filter").(string), d.Get("filter_contains").(string)}
+	if _, err := h.Write([]byte(strings.Join(values, "\n"))); err != nil {
+		return "", fmt.Errorf(" Unable to compute hash for Git refs filters: %v", err)
+	}
+	return fmt.Sprintf("refs-%s#%s", d.Get("repository_id").(string), base64.URLEncoding.EncodeToString(h.Sum(nil))), nil
+}
+
+// getAllRefs returns all refs matching the args, following continuation tokens.
+func getAllRefs(clients *client.AggregatedClient, args git.GetRefsArgs) ([]git.GitRef, error) {
+	var refs []git.GitRef
+	for {
+		resp, err := clients.GitReposClient.GetRefs(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, resp.Value...)
+		if resp.ContinuationToken == "" {
+			return refs, nil
+		}
+		args.ContinuationToken = converter.String(resp.ContinuationToken)
+	}
+}
+
+func flattenGitRefs(refs []git.GitRef) []interface{} {
+	results := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		results = append(results, map[string]interface{}{
+			"name":             converter.ToString(ref.Name, ""),
+			"object_id":        converter.ToString(ref.ObjectId, ""),
+			"peeled_object_id": converter.ToString(ref.PeeledObjectId, ""),
+			"is_locked":        converter.ToBool(ref.IsLocked, false),
+		})
+	}
+	return results
+}
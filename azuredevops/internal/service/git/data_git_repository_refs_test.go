@@ -0,0 +1,113 @@
+//go:build (all || git || data_sources || data_git_repository_refs) && (!exclude_data_sources || !exclude_git || !exclude_data_git_repository_refs)
+// +build all git data_sources data_git_repository_refs
+// +build !exclude_data_sources !exclude_git !exclude_data_git_repository_refs
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRepositoryRefsDataSource_Read_DontSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@GetRefs@@failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositoryRefs().Schema, nil)
+	resourceData.Set("repository_id", "00000000-0000-0000-0000-000000000001")
+
+	diags := dataSourceGitRepositoryRefsRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "@@GetRefs@@failed")
+}
+
+func TestGitRepositoryRefsDataSource_Read_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoId := "00000000-0000-0000-0000-000000000001"
+	args := git.GetRefsArgs{
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String("tags/"),
+		PeelTags:     converter.Bool(true),
+	}
+	repoClient.
+		EXPECT().
+		GetRefs(clients.Ctx, args).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/v1"), ObjectId: converter.String("commit-1")},
+			},
+			ContinuationToken: "a-token",
+		}, nil).
+		Times(1)
+
+	args.ContinuationToken = converter.String("a-token")
+	repoClient.
+		EXPECT().
+		GetRefs(clients.Ctx, args).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/v2"), ObjectId: converter.String("tag-2"), PeeledObjectId: converter.String("commit-2")},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositoryRefs().Schema, nil)
+	resourceData.Set("repository_id", repoId)
+	resourceData.Set("filter", "tags/")
+
+	diags := dataSourceGitRepositoryRefsRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+
+	refs := resourceData.Get("refs").([]interface{})
+	require.Len(t, refs, 2)
+	require.Equal(t, "refs/tags/v1", refs[0].(map[string]interface{})["name"])
+	require.Equal(t, "commit-2", refs[1].(map[string]interface{})["peeled_object_id"])
+}
+
+func TestGitRepositoryRefsDataSource_ID_DependsOnFilters(t *testing.T) {
+	repoId := "00000000-0000-0000-0000-000000000001"
+	ids := map[string]bool{}
+	for _, filters := range []map[string]interface{}{
+		{},
+		{"filter": "heads/"},
+		{"filter": "tags/"},
+		{"filter": "tags/", "filter_contains": "v1"},
+	} {
+		filters["repository_id"] = repoId
+		resourceData := schema.TestResourceDataRaw(t, DataGitRepositoryRefs().Schema, filters)
+		id, err := createGitRepositoryRefsDataSourceID(resourceData)
+		require.Nil(t, err)
+		require.Contains(t, id, repoId)
+		ids[id] = true
+	}
+	require.Len(t, ids, 4)
+}
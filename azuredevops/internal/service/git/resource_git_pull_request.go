@@ -0,0 +1,659 @@
+package git
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const emptyIdentityId = "00000000-0000-0000-0000-000000000000"
+
+// ResourceGitPullRequest schema to manage the lifecycle of a pull request with the file changes it proposes
+func ResourceGitPullRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitPullRequestCreate,
+		ReadContext:   resourceGitPullRequestRead,
+		UpdateContext: resourceGitPullRequestUpdate,
+		DeleteContext: resourceGitPullRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"source_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressBranchPrefix,
+			},
+			"target_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressBranchPrefix,
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"draft": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"file": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"reviewer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"work_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"auto_complete": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+								string(git.GitPullRequestMergeStrategyValues.Squash),
+								string(git.GitPullRequestMergeStrategyValues.Rebase),
+								string(git.GitPullRequestMergeStrategyValues.RebaseMerge),
+							}, false),
+						},
+						"merge_commit_message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"delete_source_branch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"transition_work_items": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"pull_request_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merge_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merge_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitPullRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	sourceBranch := withPrefix(REF_BRANCH_PREFIX, d.Get("source_branch").(string))
+	targetBranch := withPrefix(REF_BRANCH_PREFIX, d.Get("target_branch").(string))
+
+	sourceRef, err := getRef(clients, repoId, sourceBranch)
+	if err != nil {
+		return diag.Errorf(" Getting source branch %q: %+v", sourceBranch, err)
+	}
+	if sourceRef != nil {
+		return diag.Errorf(" Source branch %q already exists, the source branch is created by the pull request.", sourceBranch)
+	}
+
+	targetCommitId, err := getRefCommitId(clients, repoId, targetBranch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Pushing the changes based on the last commit of the target branch creates the source branch
+	changes, err := expandPullRequestFileChanges(clients, repoId, targetBranch, d.Get("file").(*schema.Set).List(), nil)
+	if err != nil {
+		return diag.Errorf(" Preparing file changes: %+v", err)
+	}
+	if err := pushPullRequestChanges(clients, d, repoId, sourceBranch, targetCommitId, changes); err != nil {
+		return diag.Errorf(" Pushing file changes to source branch %q: %+v", sourceBranch, err)
+	}
+
+	pullRequest := &git.GitPullRequest{
+		SourceRefName: converter.String(sourceBranch),
+		TargetRefName: converter.String(targetBranch),
+		Title:         converter.String(d.Get("title").(string)),
+		Description:   converter.String(d.Get("description").(string)),
+		IsDraft:       converter.Bool(d.Get("draft").(bool)),
+		Reviewers:     expandPullRequestReviewers(d.Get("reviewer").(*schema.Set).List()),
+		WorkItemRefs:  expandPullRequestWorkItemRefs(d.Get("work_item_ids").(*schema.Set).List()),
+	}
+	createdPullRequest, err := clients.GitReposClient.CreatePullRequest(clients.Ctx, git.CreatePullRequestArgs{
+		RepositoryId:           converter.String(repoId),
+		GitPullRequestToCreate: pullRequest,
+	})
+	if err != nil {
+		// The source branch was created by the push, it is removed so that the creation can be retried
+		if deleteErr := deletePullRequestSourceBranch(clients, repoId, sourceBranch); deleteErr != nil {
+			return diag.Errorf(" Creating pull request from %q into %q: %+v. Deleting source branch %q: %+v", sourceBranch, targetBranch, err, sourceBranch, deleteErr)
+		}
+		return diag.Errorf(" Creating pull request from %q into %q: %+v", sourceBranch, targetBranch, err)
+	}
+
+	d.SetId(strconv.Itoa(*createdPullRequest.PullRequestId))
+
+	if v, ok := d.GetOk("auto_complete"); ok && len(v.([]interface{})) > 0 {
+		err := updatePullRequest(clients, repoId, *createdPullRequest.PullRequestId, &git.GitPullRequest{
+			AutoCompleteSetBy: &webapi.IdentityRef{Id: createdPullRequest.CreatedBy.Id},
+			CompletionOptions: expandPullRequestCompletionOptions(v.([]interface{})),
+		})
+		if err != nil {
+			return diag.Errorf(" Setting auto-complete of pull request %s: %+v", d.Id(), err)
+		}
+	}
+
+	return resourceGitPullRequestRead(ctx, d, m)
+}
+
+func resourceGitPullRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	pullRequestId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing pull request ID %q: %+v", d.Id(), err)
+	}
+
+	pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:        converter.String(repoId),
+		PullRequestId:       converter.Int(pullRequestId),
+		IncludeWorkItemRefs: converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading pull request %d: %+v", pullRequestId, err)
+	}
+
+	d.Set("pull_request_id", pullRequest.PullRequestId)
+	d.Set("source_branch", shortBranchName(converter.ToString(pullRequest.SourceRefName, "")))
+	d.Set("target_branch", shortBranchName(converter.ToString(pullRequest.TargetRefName, "")))
+	d.Set("title", pullRequest.Title)
+	d.Set("description", pullRequest.Description)
+	d.Set("draft", converter.ToBool(pullRequest.IsDraft, false))
+	d.Set("url", pullRequest.Url)
+	d.Set("work_item_ids", flattenPullRequestWorkItemRefs(pullRequest.WorkItemRefs))
+
+	if pullRequest.Status != nil {
+		d.Set("status", string(*pullRequest.Status))
+	}
+	if pullRequest.MergeStatus != nil {
+		d.Set("merge_status", string(*pullRequest.MergeStatus))
+	}
+	if pullRequest.LastMergeSourceCommit != nil {
+		d.Set("source_commit_id", pullRequest.LastMergeSourceCommit.CommitId)
+	}
+	if pullRequest.LastMergeCommit != nil {
+		d.Set("merge_commit_id", pullRequest.LastMergeCommit.CommitId)
+	}
+
+	// Reviewers added by branch policies are not managed by this resource
+	configuredReviewers := map[string]bool{}
+	for _, reviewer := range d.Get("reviewer").(*schema.Set).List() {
+		configuredReviewers[reviewer.(map[string]interface{})["id"].(string)] = true
+	}
+	if err := d.Set("reviewer", flattenPullRequestReviewers(pullRequest.Reviewers, configuredReviewers)); err != nil {
+		return diag.Errorf(" setting reviewer: %+v", err)
+	}
+
+	if pullRequest.AutoCompleteSetBy == nil || converter.ToString(pullRequest.AutoCompleteSetBy.Id, emptyIdentityId) == emptyIdentityId {
+		d.Set("auto_complete", nil)
+	} else if err := d.Set("auto_complete", flattenPullRequestCompletionOptions(pullRequest.CompletionOptions)); err != nil {
+		return diag.Errorf(" setting auto_complete: %+v", err)
+	}
+	return nil
+}
+
+func resourceGitPullRequestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	pullRequestId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing pull request ID %q: %+v", d.Id(), err)
+	}
+
+	if status := d.Get("status").(string); status != string(git.PullRequestStatusValues.Active) {
+		return diag.Errorf(" Pull request %d can not be updated as it is %s", pullRequestId, status)
+	}
+
+	if d.HasChange("file") {
+		sourceBranch := withPrefix(REF_BRANCH_PREFIX, d.Get("source_branch").(string))
+		sourceCommitId, err := getRefCommitId(clients, repoId, sourceBranch)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		oldFiles, newFiles := d.GetChange("file")
+		changes, err := expandPullRequestFileChanges(clients, repoId, sourceBranch, newFiles.(*schema.Set).List(), oldFiles.(*schema.Set).List())
+		if err != nil {
+			return diag.Errorf(" Preparing file changes: %+v", err)
+		}
+		if err := pushPullRequestChanges(clients, d, repoId, sourceBranch, sourceCommitId, changes); err != nil {
+			return diag.Errorf(" Pushing file changes to source branch %q: %+v", sourceBranch, err)
+		}
+	}
+
+	if d.HasChanges("title", "description", "draft") {
+		err := updatePullRequest(clients, repoId, pullRequestId, &git.GitPullRequest{
+			Title:       converter.String(d.Get("title").(string)),
+			Description: converter.String(d.Get("description").(string)),
+			IsDraft:     converter.Bool(d.Get("draft").(bool)),
+		})
+		if err != nil {
+			return diag.Errorf(" Updating pull request %d: %+v", pullRequestId, err)
+		}
+	}
+
+	if d.HasChange("reviewer") {
+		if err := updatePullRequestReviewers(clients, d, repoId, pullRequestId); err != nil {
+			return diag.Errorf(" Updating reviewers of pull request %d: %+v", pullRequestId, err)
+		}
+	}
+
+	if d.HasChange("auto_complete") {
+		autoComplete := d.Get("auto_complete").([]interface{})
+		pullRequest := &git.GitPullRequest{
+			AutoCompleteSetBy: &webapi.IdentityRef{Id: converter.String(emptyIdentityId)},
+		}
+		if len(autoComplete) > 0 {
+			currentPullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+				RepositoryId:  converter.String(repoId),
+				PullRequestId: converter.Int(pullRequestId),
+			})
+			if err != nil {
+				return diag.Errorf(" Reading pull request %d: %+v", pullRequestId, err)
+			}
+			pullRequest.AutoCompleteSetBy = &webapi.IdentityRef{Id: currentPullRequest.CreatedBy.Id}
+			pullRequest.CompletionOptions = expandPullRequestCompletionOptions(autoComplete)
+		}
+		if err := updatePullRequest(clients, repoId, pullRequestId, pullRequest); err != nil {
+			return diag.Errorf(" Updating auto-complete of pull request %d: %+v", pullRequestId, err)
+		}
+	}
+
+	return resourceGitPullRequestRead(ctx, d, m)
+}
+
+func resourceGitPullRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	pullRequestId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing pull request ID %q: %+v", d.Id(), err)
+	}
+
+	pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(pullRequestId),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Reading pull request %d: %+v", pullRequestId, err)
+	}
+	if pullRequest == nil {
+		return nil
+	}
+
+	// Completed pull requests are kept, their changes are part of the target branch
+	if pullRequest.Status != nil && *pullRequest.Status == git.PullRequestStatusValues.Completed {
+		return nil
+	}
+
+	if pullRequest.Status != nil && *pullRequest.Status == git.PullRequestStatusValues.Active {
+		err := updatePullRequest(clients, repoId, pullRequestId, &git.GitPullRequest{
+			Status: &git.PullRequestStatusValues.Abandoned,
+		})
+		if err != nil {
+			return diag.Errorf(" Abandoning pull request %d: %+v", pullRequestId, err)
+		}
+	}
+
+	if pullRequest.SourceRefName == nil {
+		return nil
+	}
+	if err := deletePullRequestSourceBranch(clients, repoId, *pullRequest.SourceRefName); err != nil {
+		return diag.Errorf(" Deleting source branch %q: %+v", *pullRequest.SourceRefName, err)
+	}
+	return nil
+}
+
+// deletePullRequestSourceBranch deletes the source branch of the pull request if it exists
+func deletePullRequestSourceBranch(clients *client.AggregatedClient, repoId, sourceBranch string) error {
+	sourceRef, err := getRef(clients, repoId, sourceBranch)
+	if err != nil || sourceRef == nil {
+		return err
+	}
+	_, err = updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(sourceBranch),
+			OldObjectId: sourceRef.ObjectId,
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(repoId),
+	})
+	return err
+}
+
+// suppressBranchPrefix suppresses the diff between the short and the fully qualified name of the same branch
+func suppressBranchPrefix(_, old, new string, _ *schema.ResourceData) bool {
+	return withPrefix(REF_BRANCH_PREFIX, old) == withPrefix(REF_BRANCH_PREFIX, new)
+}
+
+func updatePullRequest(clients *client.AggregatedClient, repoId string, pullRequestId int, pullRequest *git.GitPullRequest) error {
+	_, err := clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+		RepositoryId:           converter.String(repoId),
+		PullRequestId:          converter.Int(pullRequestId),
+		GitPullRequestToUpdate: pullRequest,
+	})
+	return err
+}
+
+func updatePullRequestReviewers(clients *client.AggregatedClient, d *schema.ResourceData, repoId string, pullRequestId int) error {
+	oldReviewers, newReviewers := d.GetChange("reviewer")
+
+	for _, reviewer := range oldReviewers.(*schema.Set).Difference(newReviewers.(*schema.Set)).List() {
+		reviewerId := reviewer.(map[string]interface{})["id"].(string)
+		if isReviewerConfigured(newReviewers.(*schema.Set), reviewerId) {
+			continue
+		}
+		err := clients.GitReposClient.DeletePullRequestReviewer(clients.Ctx, git.DeletePullRequestReviewerArgs{
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(pullRequestId),
+			ReviewerId:    converter.String(reviewerId),
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return err
+		}
+	}
+
+	for _, reviewer := range newReviewers.(*schema.Set).Difference(oldReviewers.(*schema.Set)).List() {
+		reviewerId := reviewer.(map[string]interface{})["id"].(string)
+		_, err := clients.GitReposClient.CreatePullRequestReviewer(clients.Ctx, git.CreatePullRequestReviewerArgs{
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(pullRequestId),
+			ReviewerId:    converter.String(reviewerId),
+			Reviewer: &git.IdentityRefWithVote{
+				Id:         converter.String(reviewerId),
+				IsRequired: converter.Bool(reviewer.(map[string]interface{})["required"].(bool)),
+				Vote:       converter.Int(0),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isReviewerConfigured(reviewers *schema.Set, reviewerId string) bool {
+	for _, reviewer := range reviewers.List() {
+		if reviewer.(map[string]interface{})["id"].(string) == reviewerId {
+			return true
+		}
+	}
+	return false
+}
+
+// pushPullRequestChanges pushes a single commit with the changes on top of the given commit of the branch.
+func pushPullRequestChanges(clients *client.AggregatedClient, d *schema.ResourceData, repoId, branch, oldObjectId string, changes []interface{}) error {
+	message := d.Get("commit_message").(string)
+	if message == "" {
+		message = d.Get("title").(string)
+	}
+
+	_, err := clients.GitReposClient.CreatePush(clients.Ctx, git.CreatePushArgs{
+		RepositoryId: converter.String(repoId),
+		Push: &git.GitPush{
+			RefUpdates: &[]git.GitRefUpdate{
+				{
+					Name:        converter.String(branch),
+					OldObjectId: converter.String(oldObjectId),
+				},
+			},
+			Commits: &[]git.GitCommitRef{
+				{
+					Comment: converter.String(message),
+					Changes: &changes,
+				},
+			},
+		},
+	})
+	return err
+}
+
+// expandPullRequestFileChanges returns the changes to push to the branch. Files existing in the branch are edited, other files
+// are added. Files which are no longer configured are deleted.
+func expandPullRequestFileChanges(clients *client.AggregatedClient, repoId, branch string, files []interface{}, oldFiles []interface{}) ([]interface{}, error) {
+	changes := []interface{}{}
+	paths := map[string]bool{}
+	for _, raw := range files {
+		file := raw.(map[string]interface{})
+		path := file["path"].(string)
+		paths[path] = true
+
+		err := checkRepositoryFileExists(clients, repoId, path, branch)
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return nil, err
+		}
+		changeType := git.VersionControlChangeTypeValues.Edit
+		if err != nil {
+			changeType = git.VersionControlChangeTypeValues.Add
+		}
+
+		changes = append(changes, git.GitChange{
+			ChangeType: &changeType,
+			Item: git.GitItem{
+				Path: converter.String(path),
+			},
+			NewContent: &git.ItemContent{
+				Content:     converter.String(file["content"].(string)),
+				ContentType: &git.ItemContentTypeValues.RawText,
+			},
+		})
+	}
+
+	for _, raw := range oldFiles {
+		path := raw.(map[string]interface{})["path"].(string)
+		if paths[path] {
+			continue
+		}
+		paths[path] = true
+		changes = append(changes, git.GitChange{
+			ChangeType: &git.VersionControlChangeTypeValues.Delete,
+			Item: git.GitItem{
+				Path: converter.String(path),
+			},
+		})
+	}
+	return changes, nil
+}
+
+func expandPullRequestReviewers(reviewers []interface{}) *[]git.IdentityRefWithVote {
+	result := []git.IdentityRefWithVote{}
+	for _, raw := range reviewers {
+		reviewer := raw.(map[string]interface{})
+		result = append(result, git.IdentityRefWithVote{
+			Id:         converter.String(reviewer["id"].(string)),
+			IsRequired: converter.Bool(reviewer["required"].(bool)),
+		})
+	}
+	return &result
+}
+
+func flattenPullRequestReviewers(reviewers *[]git.IdentityRefWithVote, configured map[string]bool) []interface{} {
+	result := []interface{}{}
+	if reviewers == nil {
+		return result
+	}
+	for _, reviewer := range *reviewers {
+		if reviewer.Id == nil || !configured[*reviewer.Id] {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"id":       *reviewer.Id,
+			"required": converter.ToBool(reviewer.IsRequired, false),
+		})
+	}
+	return result
+}
+
+func expandPullRequestWorkItemRefs(workItemIds []interface{}) *[]webapi.ResourceRef {
+	result := []webapi.ResourceRef{}
+	for _, id := range workItemIds {
+		result = append(result, webapi.ResourceRef{
+			Id: converter.String(strconv.Itoa(id.(int))),
+		})
+	}
+	return &result
+}
+
+func flattenPullRequestWorkItemRefs(workItemRefs *[]webapi.ResourceRef) []interface{} {
+	result := []interface{}{}
+	if workItemRefs == nil {
+		return result
+	}
+	for _, ref := range *workItemRefs {
+		if ref.Id == nil {
+			continue
+		}
+		if id, err := strconv.Atoi(*ref.Id); err == nil {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func expandPullRequestCompletionOptions(autoComplete []interface{}) *git.GitPullRequestCompletionOptions {
+	options := autoComplete[0].(map[string]interface{})
+	mergeStrategy := git.GitPullRequestMergeStrategy(options["merge_strategy"].(string))
+	completionOptions := &git.GitPullRequestCompletionOptions{
+		MergeStrategy:       &mergeStrategy,
+		DeleteSourceBranch:  converter.Bool(options["delete_source_branch"].(bool)),
+		TransitionWorkItems: converter.Bool(options["transition_work_items"].(bool)),
+	}
+	if message := options["merge_commit_message"].(string); message != "" {
+		completionOptions.MergeCommitMessage = converter.String(message)
+	}
+	return completionOptions
+}
+
+func flattenPullRequestCompletionOptions(options *git.GitPullRequestCompletionOptions) []interface{} {
+	if options == nil {
+		return nil
+	}
+
+	mergeStrategy := string(git.GitPullRequestMergeStrategyValues.NoFastForward)
+	if options.MergeStrategy != nil {
+		mergeStrategy = string(*options.MergeStrategy)
+	} else if converter.ToBool(options.SquashMerge, false) {
+		mergeStrategy = string(git.GitPullRequestMergeStrategyValues.Squash)
+	}
+	return []interface{}{map[string]interface{}{
+		"merge_strategy":        mergeStrategy,
+		"merge_commit_message":  converter.ToString(options.MergeCommitMessage, ""),
+		"delete_source_branch":  converter.ToBool(options.DeleteSourceBranch, false),
+		"transition_work_items": converter.ToBool(options.TransitionWorkItems, false),
+	}}
+}
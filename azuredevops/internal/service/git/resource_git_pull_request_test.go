@@ -0,0 +1,239 @@
+//go:build (all || git || resource_git_pull_request) && (!exclude_git || !exclude_resource_git_pull_request)
+// +build all git resource_git_pull_request
+// +build !exclude_git !exclude_resource_git_pull_request
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitPullRequest_Create_DoesNotOverwriteSourceBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String("a-repo"),
+			Filter:       converter.String("heads/a-branch"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/heads/a-branch"), ObjectId: converter.String("a-commit")}},
+		}, nil)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": "a-repo",
+		"source_branch": "a-branch",
+		"target_branch": "main",
+		"title":         "a-title",
+		"file": []interface{}{
+			map[string]interface{}{"path": "foo.txt", "content": "bar"},
+		},
+	})
+
+	diags := resourceGitPullRequestCreate(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Source branch \"refs/heads/a-branch\" already exists")
+}
+
+func TestGitPullRequest_Create_DeletesSourceBranchWhenCreationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gomock.InOrder(
+		gitClient.EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId: converter.String("a-repo"),
+				Filter:       converter.String("heads/a-branch"),
+				PeelTags:     converter.Bool(true),
+			}).
+			Return(&git.GetRefsResponseValue{}, nil),
+		gitClient.EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId: converter.String("a-repo"),
+				Filter:       converter.String("heads/main"),
+				Top:          converter.Int(1),
+				PeelTags:     converter.Bool(true),
+			}).
+			Return(&git.GetRefsResponseValue{
+				Value: []git.GitRef{{Name: converter.String("refs/heads/main"), ObjectId: converter.String("main-commit")}},
+			}, nil),
+		gitClient.EXPECT().
+			GetItem(clients.Ctx, gomock.Any()).
+			Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}),
+		gitClient.EXPECT().
+			CreatePush(clients.Ctx, gomock.Any()).
+			Return(&git.GitPush{}, nil),
+		gitClient.EXPECT().
+			CreatePullRequest(clients.Ctx, gomock.Any()).
+			Return(nil, errors.New("CreatePullRequest() Failed")),
+		gitClient.EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId: converter.String("a-repo"),
+				Filter:       converter.String("heads/a-branch"),
+				PeelTags:     converter.Bool(true),
+			}).
+			Return(&git.GetRefsResponseValue{
+				Value: []git.GitRef{{Name: converter.String("refs/heads/a-branch"), ObjectId: converter.String("pushed-commit")}},
+			}, nil),
+		gitClient.EXPECT().
+			UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+				RefUpdates: &[]git.GitRefUpdate{{
+					Name:        converter.String("refs/heads/a-branch"),
+					OldObjectId: converter.String("pushed-commit"),
+					NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+				}},
+				RepositoryId: converter.String("a-repo"),
+			}).
+			Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": "a-repo",
+		"source_branch": "refs/heads/a-branch",
+		"target_branch": "main",
+		"title":         "a-title",
+		"file": []interface{}{
+			map[string]interface{}{"path": "foo.txt", "content": "bar"},
+		},
+	})
+
+	diags := resourceGitPullRequestCreate(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "CreatePullRequest() Failed")
+	require.Equal(t, "", d.Id())
+}
+
+func TestGitPullRequest_SuppressBranchPrefix(t *testing.T) {
+	require.True(t, suppressBranchPrefix("", "a-branch", "refs/heads/a-branch", nil))
+	require.True(t, suppressBranchPrefix("", "refs/heads/a-branch", "a-branch", nil))
+	require.False(t, suppressBranchPrefix("", "a-branch", "refs/heads/b-branch", nil))
+}
+
+func TestGitPullRequest_Update_DoesNotUpdateCompletedPullRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": "a-repo",
+		"source_branch": "a-branch",
+		"target_branch": "main",
+		"title":         "a-title",
+		"file": []interface{}{
+			map[string]interface{}{"path": "foo.txt", "content": "bar"},
+		},
+	})
+	d.SetId("1")
+	d.Set("status", "completed")
+
+	diags := resourceGitPullRequestUpdate(context.Background(), d, &client.AggregatedClient{Ctx: context.Background()})
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Pull request 1 can not be updated as it is completed")
+}
+
+func TestGitPullRequest_ExpandFileChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.GetItemArgs) (*git.GitItem, error) {
+			if *args.Path == "existing.txt" {
+				return &git.GitItem{Path: args.Path}, nil
+			}
+			return nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}
+		}).
+		Times(2)
+
+	files := []interface{}{
+		map[string]interface{}{"path": "existing.txt", "content": "foo"},
+		map[string]interface{}{"path": "new.txt", "content": "bar"},
+	}
+	oldFiles := []interface{}{
+		map[string]interface{}{"path": "existing.txt", "content": "baz"},
+		map[string]interface{}{"path": "removed.txt", "content": "baz"},
+	}
+
+	changes, err := expandPullRequestFileChanges(clients, "a-repo", "refs/heads/a-branch", files, oldFiles)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	expected := map[string]git.VersionControlChangeType{
+		"existing.txt": git.VersionControlChangeTypeValues.Edit,
+		"new.txt":      git.VersionControlChangeTypeValues.Add,
+		"removed.txt":  git.VersionControlChangeTypeValues.Delete,
+	}
+	for _, raw := range changes {
+		change := raw.(git.GitChange)
+		path := *change.Item.(git.GitItem).Path
+		require.Equal(t, expected[path], *change.ChangeType, fmt.Sprintf("unexpected change type of %s", path))
+	}
+}
+
+func TestGitPullRequest_FlattenReviewers_IgnoresPolicyReviewers(t *testing.T) {
+	reviewers := &[]git.IdentityRefWithVote{
+		{Id: converter.String("configured"), IsRequired: converter.Bool(true)},
+		{Id: converter.String("added-by-policy"), IsRequired: converter.Bool(true)},
+	}
+
+	result := flattenPullRequestReviewers(reviewers, map[string]bool{"configured": true})
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"id": "configured", "required": true},
+	}, result)
+}
+
+func TestGitPullRequest_Delete_HandlesPullRequestWithoutStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+			RepositoryId:  converter.String("a-repo"),
+			PullRequestId: converter.Int(7),
+		}).
+		Return(&git.GitPullRequest{PullRequestId: converter.Int(7)}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": "a-repo",
+	})
+	d.SetId("7")
+
+	diags := resourceGitPullRequestDelete(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -43,15 +45,18 @@ type repoInitializationMeta struct {
 	sourceType          string
 	sourceURL           string
 	serviceConnectionID string
+	username            string
+	password            string
 }
 
 // ResourceGitRepository schema and implementation for git repo resource
 func ResourceGitRepository() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGitRepositoryCreate,
-		Read:   resourceGitRepositoryRead,
-		Update: resourceGitRepositoryUpdate,
-		Delete: resourceGitRepositoryDelete,
+		Create:        resourceGitRepositoryCreate,
+		Read:          resourceGitRepositoryRead,
+		Update:        resourceGitRepositoryUpdate,
+		Delete:        resourceGitRepositoryDelete,
+		CustomizeDiff: customizeDiffForkSyncDrift,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -114,7 +119,25 @@ func ResourceGitRepository() *schema.Resource {
 								"initialization.0.source_url",
 								"initialization.0.source_type",
 							},
-							Default: "",
+							ConflictsWith: []string{"initialization.0.username"},
+							Default:       "",
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+							RequiredWith: []string{
+								"initialization.0.source_url",
+								"initialization.0.source_type",
+								"initialization.0.password",
+							},
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"password": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"initialization.0.username"},
+							ValidateFunc: validation.StringIsNotWhiteSpace,
 						},
 					},
 				},
@@ -125,6 +148,35 @@ func ResourceGitRepository() *schema.Resource {
 				ValidateFunc:     validation.IsUUID,
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			"fork_sync": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"parent_repository_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branches": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						"triggers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"fork_sync_drifted_branches": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"default_branch": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -159,6 +211,25 @@ func ResourceGitRepository() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restore": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"permanent_delete": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -176,6 +247,25 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	features := expandGitRepositoryFeatures(d.Get("features").([]interface{}))
+	if v, ok := features["restore"]; ok && v.(bool) {
+		restoredRepo, err := restoreGitRepository(clients, *repo.Name, projectID.String())
+		if err != nil {
+			return fmt.Errorf(" restoring repository %s from the recycle bin: %+v", *repo.Name, err)
+		}
+		if restoredRepo != nil {
+			d.SetId(restoredRepo.Id.String())
+			if v := d.Get("default_branch").(string); v != "" {
+				restoredRepo.DefaultBranch = converter.String(v)
+				_, err = updateGitRepository(clients, restoredRepo, projectID)
+				if err != nil {
+					return fmt.Errorf(" updating repository `default_branch`: %+v", err)
+				}
+			}
+			return resourceGitRepositoryRead(d, m)
+		}
+	}
+
 	var parentRepoRef *git.GitRepositoryRef = nil
 	if parentRepoID, ok := d.GetOk("parent_repository_id"); ok {
 		parentRepo, err := gitRepositoryRead(clients, parentRepoID.(string), "", "")
@@ -200,21 +290,7 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 
 	if initialization != nil {
 		if strings.EqualFold(initialization.initType, string(RepoInitTypeValues.Import)) && strings.EqualFold(initialization.sourceType, "Git") {
-			importRequest := git.GitImportRequest{
-				Parameters: &git.GitImportRequestParameters{
-					GitSource: &git.GitImportGitSource{
-						Url: &initialization.sourceURL,
-					},
-				},
-				Repository: createdRepo,
-			}
-
-			if initialization.serviceConnectionID != "" {
-				importRequest.Parameters.ServiceEndpointId = converter.UUID(initialization.serviceConnectionID)
-				importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(false)
-			}
-
-			_, importErr := createImportRequest(clients, importRequest, projectID.String(), *createdRepo.Name)
+			importErr := importGitRepository(clients, createdRepo, projectID, initialization, d.Timeout(schema.TimeoutCreate))
 			if importErr != nil {
 				return fmt.Errorf("Error import repository in Azure DevOps: %+v ", importErr)
 			}
@@ -245,6 +321,13 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if branches := expandForkSyncBranches(d); len(branches) > 0 {
+		err = syncGitRepositoryFork(clients, createdRepo.Id.String(), projectID.String(), parentRepoRef, branches, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceGitRepositoryRead(d, m)
 }
 
@@ -272,6 +355,11 @@ func resourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Failed to flatten Git repository: %w", err)
 	}
+
+	err = flattenGitRepositoryForkSyncDrift(clients, d, repo)
+	if err != nil {
+		return fmt.Errorf(" checking the fork sync state of repository %s: %+v", repoID, err)
+	}
 	return nil
 }
 
@@ -287,6 +375,23 @@ func resourceGitRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating repository in Azure DevOps: %+v", err)
 	}
 
+	branches := expandForkSyncBranches(d)
+	if d.HasChanges("fork_sync", "fork_sync_drifted_branches") && len(branches) > 0 {
+		parentRepoID := d.Get("parent_repository_id").(string)
+		parentRepo, err := gitRepositoryRead(clients, parentRepoID, "", "")
+		if err != nil {
+			return fmt.Errorf("Failed to locate parent repository [%s]: %+v", parentRepoID, err)
+		}
+		parentRepoRef := &git.GitRepositoryRef{
+			Id:      parentRepo.Id,
+			Project: parentRepo.Project,
+		}
+		err = syncGitRepositoryFork(clients, d.Id(), projectID.String(), parentRepoRef, branches, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceGitRepositoryRead(d, m)
 }
 
@@ -298,6 +403,17 @@ func resourceGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	features := expandGitRepositoryFeatures(d.Get("features").([]interface{}))
+	if v, ok := features["permanent_delete"]; ok && v.(bool) {
+		err = clients.GitReposClient.DeleteRepositoryFromRecycleBin(clients.Ctx, git.DeleteRepositoryFromRecycleBinArgs{
+			Project:      converter.String(d.Get("project_id").(string)),
+			RepositoryId: converter.UUID(repoID),
+		})
+		if err != nil {
+			return fmt.Errorf(" purging repository %s from the recycle bin: %+v", repoID, err)
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -330,6 +446,259 @@ func waitForBranch(clients *client.AggregatedClient, repoName *string, projectID
 	return nil
 }
 
+// importGitRepository imports the source repository described by the initialization into the given repository
+// and waits for the import to finish. If credentials are specified, a temporary service endpoint is created to
+// authenticate against the source repository, which is removed by the service once the import is done.
+func importGitRepository(clients *client.AggregatedClient, repo *git.GitRepository, projectID *uuid.UUID, initialization *repoInitializationMeta, timeout time.Duration) error {
+	importRequest := git.GitImportRequest{
+		Parameters: &git.GitImportRequestParameters{
+			GitSource: &git.GitImportGitSource{
+				Url: &initialization.sourceURL,
+			},
+		},
+		Repository: repo,
+	}
+
+	var tempServiceEndpointID *uuid.UUID
+	if initialization.serviceConnectionID != "" {
+		importRequest.Parameters.ServiceEndpointId = converter.UUID(initialization.serviceConnectionID)
+		importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(false)
+	} else if initialization.username != "" {
+		serviceEndpoint, err := createImportServiceEndpoint(clients, repo, projectID, initialization)
+		if err != nil {
+			return fmt.Errorf(" creating service endpoint to authenticate the import: %+v", err)
+		}
+		tempServiceEndpointID = serviceEndpoint.Id
+		importRequest.Parameters.ServiceEndpointId = serviceEndpoint.Id
+		importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(true)
+	}
+
+	createdImportRequest, err := createImportRequest(clients, importRequest, projectID.String(), *repo.Name)
+	if err != nil {
+		if tempServiceEndpointID != nil {
+			deleteImportServiceEndpoint(clients, tempServiceEndpointID, projectID)
+		}
+		return err
+	}
+
+	return waitForImport(clients, createdImportRequest, projectID.String(), *repo.Name, timeout)
+}
+
+func createImportServiceEndpoint(clients *client.AggregatedClient, repo *git.GitRepository, projectID *uuid.UUID, initialization *repoInitializationMeta) (*serviceendpoint.ServiceEndpoint, error) {
+	name := converter.String(fmt.Sprintf("terraform-import-%s", repo.Id.String()))
+	return clients.ServiceEndpointClient.CreateServiceEndpoint(clients.Ctx, serviceendpoint.CreateServiceEndpointArgs{
+		Endpoint: &serviceendpoint.ServiceEndpoint{
+			Name:  name,
+			Owner: converter.String("library"),
+			Type:  converter.String("git"),
+			Url:   converter.String(initialization.sourceURL),
+			Authorization: &serviceendpoint.EndpointAuthorization{
+				Parameters: &map[string]string{
+					"username": initialization.username,
+					"password": initialization.password,
+				},
+				Scheme: converter.String("UsernamePassword"),
+			},
+			ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+				{
+					ProjectReference: &serviceendpoint.ProjectReference{
+						Id: projectID,
+					},
+					Name: name,
+				},
+			},
+		},
+	})
+}
+
+func deleteImportServiceEndpoint(clients *client.AggregatedClient, serviceEndpointID *uuid.UUID, projectID *uuid.UUID) {
+	err := clients.ServiceEndpointClient.DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: serviceEndpointID,
+		ProjectIds: &[]string{projectID.String()},
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		log.Printf("[WARN] Failed to delete service endpoint %s used to import the repository: %+v", serviceEndpointID, err)
+	}
+}
+
+func waitForImport(clients *client.AggregatedClient, importRequest *git.GitImportRequest, projectID string, repoName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target: []string{string(git.GitAsyncOperationStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			request, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+				Project:         converter.String(projectID),
+				RepositoryId:    converter.String(repoName),
+				ImportRequestId: importRequest.ImportRequestId,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf(" reading import request: %+v", err)
+			}
+
+			status := git.GitAsyncOperationStatusValues.Queued
+			if request.Status != nil {
+				status = *request.Status
+			}
+			if status == git.GitAsyncOperationStatusValues.Failed || status == git.GitAsyncOperationStatusValues.Abandoned {
+				errorMessage := ""
+				if request.DetailedStatus != nil {
+					errorMessage = converter.ToString(request.DetailedStatus.ErrorMessage, "")
+				}
+				return nil, "", fmt.Errorf(" import request %d is %s: %s", *importRequest.ImportRequestId, status, errorMessage)
+			}
+			return request, string(status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil { //nolint:staticcheck
+		return fmt.Errorf(" waiting for import of repository [%s]: %+v", repoName, err)
+	}
+	return nil
+}
+
+// syncGitRepositoryFork synchronizes the given branches of a fork with the branches of the same name in its
+// parent repository and waits for the synchronization to finish.
+func syncGitRepositoryFork(clients *client.AggregatedClient, repoID string, projectID string, parentRepo *git.GitRepositoryRef, branches []string, timeout time.Duration) error {
+	if parentRepo == nil || parentRepo.Project == nil {
+		return fmt.Errorf(" `fork_sync` requires the repository to be a fork of another repository")
+	}
+
+	refs := make([]git.SourceToTargetRef, 0, len(branches))
+	for _, branch := range branches {
+		refs = append(refs, git.SourceToTargetRef{
+			SourceRef: converter.String(branch),
+			TargetRef: converter.String(branch),
+		})
+	}
+
+	syncRequest, err := clients.GitReposClient.CreateForkSyncRequest(clients.Ctx, git.CreateForkSyncRequestArgs{
+		SyncParams: &git.GitForkSyncRequestParameters{
+			Source: &git.GlobalGitRepositoryKey{
+				ProjectId:    parentRepo.Project.Id,
+				RepositoryId: parentRepo.Id,
+			},
+			SourceToTargetRefs: &refs,
+		},
+		RepositoryNameOrId: converter.String(repoID),
+		Project:            converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating fork sync request for repository [%s]: %+v", repoID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target: []string{string(git.GitAsyncOperationStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			request, err := clients.GitReposClient.GetForkSyncRequest(clients.Ctx, git.GetForkSyncRequestArgs{
+				RepositoryNameOrId:  converter.String(repoID),
+				ForkSyncOperationId: syncRequest.OperationId,
+				Project:             converter.String(projectID),
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf(" reading fork sync request: %+v", err)
+			}
+
+			status := git.GitAsyncOperationStatusValues.Queued
+			if request.Status != nil {
+				status = *request.Status
+			}
+			if status == git.GitAsyncOperationStatusValues.Failed || status == git.GitAsyncOperationStatusValues.Abandoned {
+				errorMessage := ""
+				if request.DetailedStatus != nil {
+					errorMessage = converter.ToString(request.DetailedStatus.ErrorMessage, "")
+				}
+				return nil, "", fmt.Errorf(" fork sync request %d is %s: %s", *syncRequest.OperationId, status, errorMessage)
+			}
+			return request, string(status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil { //nolint:staticcheck
+		return fmt.Errorf(" waiting for fork sync of repository [%s]: %+v", repoID, err)
+	}
+	return nil
+}
+
+// customizeDiffForkSyncDrift plans the synchronization of the branches which drifted from the parent repository.
+func customizeDiffForkSyncDrift(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("fork_sync_drifted_branches").(*schema.Set).Len() > 0 {
+		return d.SetNew("fork_sync_drifted_branches", []interface{}{})
+	}
+	return nil
+}
+
+// flattenGitRepositoryForkSyncDrift sets the configured branches which no longer point to the same commit as the branch
+// of the parent repository. Branches which do not exist in the parent repository can not be synchronized and are
+// not reported.
+func flattenGitRepositoryForkSyncDrift(clients *client.AggregatedClient, d *schema.ResourceData, repo *git.GitRepository) error {
+	drifted := []interface{}{}
+	forkSync := d.Get("fork_sync").([]interface{})
+	if len(forkSync) == 0 || forkSync[0] == nil || repo.Id == nil || converter.ToBool(repo.IsDisabled, false) ||
+		repo.ParentRepository == nil || repo.ParentRepository.Id == nil {
+		return d.Set("fork_sync_drifted_branches", drifted)
+	}
+
+	parentHeads, err := getBranchHeads(clients, repo.ParentRepository.Id.String())
+	if err != nil {
+		return fmt.Errorf(" reading branches of the parent repository: %+v", err)
+	}
+	heads, err := getBranchHeads(clients, repo.Id.String())
+	if err != nil {
+		return fmt.Errorf(" reading branches: %+v", err)
+	}
+
+	for _, branch := range forkSync[0].(map[string]interface{})["branches"].(*schema.Set).List() {
+		name := withPrefix(REF_BRANCH_PREFIX, branch.(string))
+		if parentHead, ok := parentHeads[name]; ok && heads[name] != parentHead {
+			drifted = append(drifted, branch)
+		}
+	}
+	return d.Set("fork_sync_drifted_branches", drifted)
+}
+
+// getBranchHeads returns the commit each branch of the repository points to, by the full name of the branch.
+func getBranchHeads(clients *client.AggregatedClient, repoID string) (map[string]string, error) {
+	refs, err := getAllRefs(clients, git.GetRefsArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String("heads/"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make(map[string]string, len(refs))
+	for _, ref := range refs {
+		if ref.Name != nil {
+			heads[*ref.Name] = converter.ToString(ref.ObjectId, "")
+		}
+	}
+	return heads, nil
+}
+
+func expandForkSyncBranches(d *schema.ResourceData) []string {
+	forkSync := d.Get("fork_sync").([]interface{})
+	if len(forkSync) == 0 || forkSync[0] == nil {
+		return nil
+	}
+
+	var branches []string
+	for _, branch := range forkSync[0].(map[string]interface{})["branches"].(*schema.Set).List() {
+		branches = append(branches, withPrefix(REF_BRANCH_PREFIX, branch.(string)))
+	}
+	return branches
+}
+
 func createImportRequest(clients *client.AggregatedClient, gitImportRequest git.GitImportRequest, project string, repositoryID string) (*git.GitImportRequest, error) {
 	args := git.CreateImportRequestArgs{
 		ImportRequest: &gitImportRequest,
@@ -398,6 +767,57 @@ func initializeGitRepository(clients *client.AggregatedClient, repo *git.GitRepo
 	return err
 }
 
+// restoreGitRepository restores the most recently deleted repository with the given name from the
+// recycle bin of the project. It returns nil if no such repository exists in the recycle bin.
+func restoreGitRepository(clients *client.AggregatedClient, repoName string, projectID string) (*git.GitRepository, error) {
+	deletedRepos, err := clients.GitReposClient.GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidate *git.GitDeletedRepository
+	if deletedRepos != nil {
+		for i, deletedRepo := range *deletedRepos {
+			if deletedRepo.Id == nil || !strings.EqualFold(converter.ToString(deletedRepo.Name, ""), repoName) {
+				continue
+			}
+			if candidate == nil || isDeletedAfter(&deletedRepo, candidate) {
+				candidate = &(*deletedRepos)[i]
+			}
+		}
+	}
+	if candidate == nil {
+		return nil, nil
+	}
+
+	return clients.GitReposClient.RestoreRepositoryFromRecycleBin(clients.Ctx, git.RestoreRepositoryFromRecycleBinArgs{
+		Project:      converter.String(projectID),
+		RepositoryId: candidate.Id,
+		RepositoryDetails: &git.GitRecycleBinRepositoryDetails{
+			Deleted: converter.Bool(false),
+		},
+	})
+}
+
+func isDeletedAfter(a *git.GitDeletedRepository, b *git.GitDeletedRepository) bool {
+	if a.DeletedDate == nil {
+		return false
+	}
+	if b.DeletedDate == nil {
+		return true
+	}
+	return a.DeletedDate.Time.After(b.DeletedDate.Time)
+}
+
+func expandGitRepositoryFeatures(input []interface{}) map[string]interface{} {
+	if len(input) == 0 || input[0] == nil {
+		return map[string]interface{}{}
+	}
+	return input[0].(map[string]interface{})
+}
+
 func updateGitRepository(clients *client.AggregatedClient, repository *git.GitRepository, project fmt.Stringer) (*git.GitRepository, error) {
 	if nil == project {
 		return nil, fmt.Errorf("updateGitRepository: ID of project cannot be nil")
@@ -514,12 +934,16 @@ func expandGitRepository(d *schema.ResourceData) (*git.GitRepository, *repoIniti
 			sourceType:          initValues["source_type"].(string),
 			sourceURL:           initValues["source_url"].(string),
 			serviceConnectionID: initValues["service_connection_id"].(string),
+			username:            initValues["username"].(string),
+			password:            initValues["password"].(string),
 		}
 
 		if strings.EqualFold(initialization.initType, "clean") {
 			initialization.sourceType = ""
 			initialization.sourceURL = ""
 			initialization.serviceConnectionID = ""
+			initialization.username = ""
+			initialization.password = ""
 		}
 	} else if len(initData) > 1 {
 		return nil, nil, nil, fmt.Errorf("Multiple initialization blocks")
@@ -29,10 +29,15 @@ func ResourceGitRepositoryBranch() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceGitRepositoryBranchCreate,
 		ReadContext:   resourceGitRepositoryBranchRead,
+		UpdateContext: resourceGitRepositoryBranchUpdate,
 		DeleteContext: resourceGitRepositoryBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGitRepositoryBranchImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -49,30 +54,42 @@ func ResourceGitRepositoryBranch() *schema.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 			"ref_branch": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ValidateFunc:  validation.StringIsNotEmpty,
-				ConflictsWith: []string{"ref_tag", "ref_commit_id"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressSourceRefAfterImport,
+				ConflictsWith:    []string{"ref_tag", "ref_commit_id"},
 			},
 			"ref_tag": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ValidateFunc:  validation.StringIsNotEmpty,
-				ConflictsWith: []string{"ref_branch", "ref_commit_id"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressSourceRefAfterImport,
+				ConflictsWith:    []string{"ref_branch", "ref_commit_id"},
 			},
 			"ref_commit_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ValidateFunc:  validation.StringIsNotEmpty,
-				ConflictsWith: []string{"ref_branch", "ref_tag"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressSourceRefAfterImport,
+				ConflictsWith:    []string{"ref_branch", "ref_tag"},
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"last_commit_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"imported": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -98,38 +115,11 @@ func resourceGitRepositoryBranchCreate(ctx context.Context, d *schema.ResourceDa
 			rs = withPrefix(REF_TAG_PREFIX, v.(string))
 		}
 
-		filter := strings.TrimPrefix(rs, "refs/")
-		gotRefs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
-			RepositoryId: converter.String(repoId),
-			Filter:       converter.String(filter),
-			Top:          converter.Int(1),
-			PeelTags:     converter.Bool(true),
-		})
+		objectId, err := getRefCommitId(clients, repoId, rs)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf(" Getting refs matching %q: %w", filter, err))
-		}
-
-		if len(gotRefs.Value) == 0 {
-			return diag.FromErr(fmt.Errorf(" No refs found that match ref %q.", rs))
-		}
-
-		gotRef := gotRefs.Value[0]
-		if gotRef.Name == nil {
-			return diag.FromErr(fmt.Errorf(" Got unexpected GetRefs response, a ref without a name was returned."))
-		}
-
-		// Check for complete match. Sometimes refs exist that match prefix with Ref, but do not match completely.
-		if *gotRef.Name != rs {
-			return diag.FromErr(fmt.Errorf(" Ref %q not found, closest match is %q.", filter, *gotRef.Name))
-		}
-
-		if gotRef.PeeledObjectId != nil {
-			newObjectId = *gotRef.PeeledObjectId
-		} else if gotRef.ObjectId != nil {
-			newObjectId = *gotRef.ObjectId
-		} else {
-			return diag.FromErr(fmt.Errorf(" GetRefs response doesn't have a valid commit id."))
+			return diag.FromErr(err)
 		}
+		newObjectId = objectId
 	}
 
 	_, err := updateRefs(clients, git.UpdateRefsArgs{
@@ -145,6 +135,13 @@ func resourceGitRepositoryBranchCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", repoId, branchName))
+	d.Set("imported", false)
+
+	if d.Get("locked").(bool) {
+		if err := lockBranch(clients, repoId, branchName, true); err != nil {
+			return diag.FromErr(fmt.Errorf(" locking branch %q: %w", branchName, err))
+		}
+	}
 	return resourceGitRepositoryBranchRead(ctx, d, m)
 }
 
@@ -181,13 +178,40 @@ func resourceGitRepositoryBranchRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(fmt.Errorf(" Reading branch %q: %w", branchName, err))
 	}
 
+	gotRef, err := getRef(clients, repoId, REF_BRANCH_PREFIX+branchName)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" Reading lock status of branch %q: %w", branchName, err))
+	}
+	if gotRef == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", branchName)
 	d.Set("repository_id", repoId)
 	d.Set("last_commit_id", *gotBranch.Commit.CommitId)
+	d.Set("locked", converter.ToBool(gotRef.IsLocked, false))
 
 	return nil
 }
 
+func resourceGitRepositoryBranchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, branchName, err := tfhelper.ParseGitRepoBranchID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("locked") {
+		locked := d.Get("locked").(bool)
+		if err := lockBranch(clients, repoId, branchName, locked); err != nil {
+			return diag.FromErr(fmt.Errorf(" Updating lock status of branch %q: %w", branchName, err))
+		}
+	}
+	return resourceGitRepositoryBranchRead(ctx, d, m)
+}
+
 func resourceGitRepositoryBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 
@@ -208,6 +232,13 @@ func resourceGitRepositoryBranchDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf(" Getting latest commit of %q: %w", branchName, err))
 	}
 
+	// Locked branches can not be deleted
+	if d.Get("locked").(bool) {
+		if err := lockBranch(clients, repoId, branchName, false); err != nil {
+			return diag.FromErr(fmt.Errorf(" Unlocking branch %q: %w", branchName, err))
+		}
+	}
+
 	_, err = updateRefs(clients, git.UpdateRefsArgs{
 		RefUpdates: &[]git.GitRefUpdate{{
 			Name:        converter.String(REF_BRANCH_PREFIX + branchName),
@@ -238,6 +269,83 @@ func updateRefs(clients *client.AggregatedClient, args git.UpdateRefsArgs) (*[]g
 	return updateRefResults, nil
 }
 
+// lockBranch locks or unlocks the branch with the given short name.
+func lockBranch(clients *client.AggregatedClient, repoId, branchName string, locked bool) error {
+	_, err := clients.GitReposClient.UpdateRef(clients.Ctx, git.UpdateRefArgs{
+		NewRefInfo: &git.GitRefUpdate{
+			IsLocked: converter.Bool(locked),
+		},
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String("heads/" + branchName),
+	})
+	return err
+}
+
+// getRef returns the ref with the given fully qualified name, or nil if the ref does not exist.
+func getRef(clients *client.AggregatedClient, repoId, name string) (*git.GitRef, error) {
+	gotRefs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String(strings.TrimPrefix(name, "refs/")),
+		PeelTags:     converter.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range gotRefs.Value {
+		if ref.Name != nil && *ref.Name == name {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
+
+// resourceGitRepositoryBranchImport marks the branch as imported, the source ref of an imported branch is unknown.
+func resourceGitRepositoryBranchImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("imported", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressSourceRefAfterImport suppresses the diff of the source ref of an imported branch, as the source ref is unknown after an import.
+func suppressSourceRefAfterImport(_, old, _ string, d *schema.ResourceData) bool {
+	return old == "" && d.Get("imported").(bool)
+}
+
+// getRefCommitId returns the commit id the given fully qualified ref points to. Annotated tags are peeled to the tagged commit.
+func getRefCommitId(clients *client.AggregatedClient, repoId, rs string) (string, error) {
+	filter := strings.TrimPrefix(rs, "refs/")
+	gotRefs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String(filter),
+		Top:          converter.Int(1),
+		PeelTags:     converter.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf(" Getting refs matching %q: %w", filter, err)
+	}
+
+	if len(gotRefs.Value) == 0 {
+		return "", fmt.Errorf(" No refs found that match ref %q.", rs)
+	}
+
+	gotRef := gotRefs.Value[0]
+	if gotRef.Name == nil {
+		return "", fmt.Errorf(" Got unexpected GetRefs response, a ref without a name was returned.")
+	}
+
+	// Check for complete match. Sometimes refs exist that match prefix with Ref, but do not match completely.
+	if *gotRef.Name != rs {
+		return "", fmt.Errorf(" Ref %q not found, closest match is %q.", filter, *gotRef.Name)
+	}
+
+	if gotRef.PeeledObjectId != nil {
+		return *gotRef.PeeledObjectId, nil
+	} else if gotRef.ObjectId != nil {
+		return *gotRef.ObjectId, nil
+	}
+	return "", fmt.Errorf(" GetRefs response doesn't have a valid commit id.")
+}
+
 func withPrefix(prefix, name string) string {
 	if strings.HasPrefix(name, prefix) {
 		return name
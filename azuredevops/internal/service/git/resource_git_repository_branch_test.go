@@ -303,3 +303,101 @@ func TestGitRepositoryBranch_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestGitRepositoryBranch_Read_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String("a-repo"),
+			Name:         converter.String("a-branch"),
+		}).
+		Return(&git.GitBranchStats{
+			Commit: &git.GitCommitRef{
+				CommitId: converter.String("a-commit"),
+			},
+		}, nil)
+
+	gitClient.EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String("a-repo"),
+			Filter:       converter.String("heads/a-branch"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/heads/a-branch"), ObjectId: converter.String("a-commit"), IsLocked: converter.Bool(true)},
+				{Name: converter.String("refs/heads/a-branch-2"), ObjectId: converter.String("a-commit")},
+			},
+		}, nil)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, nil)
+	d.SetId("a-repo:a-branch")
+
+	diags := resourceGitRepositoryBranchRead(context.Background(), d, clients)
+	if diags != nil {
+		t.Fatalf("resourceGitRepositoryBranchRead() = %v", diags)
+	}
+	if d.Get("name") != "a-branch" || d.Get("repository_id") != "a-repo" || d.Get("last_commit_id") != "a-commit" {
+		t.Errorf("unexpected state: %v", d.State())
+	}
+	if !d.Get("locked").(bool) {
+		t.Errorf("expected branch to be locked")
+	}
+}
+
+func TestGitRepositoryBranch_Update_Lock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		UpdateRef(clients.Ctx, git.UpdateRefArgs{
+			NewRefInfo: &git.GitRefUpdate{
+				IsLocked: converter.Bool(true),
+			},
+			RepositoryId: converter.String("a-repo"),
+			Filter:       converter.String("heads/a-branch"),
+		}).
+		Return(nil, fmt.Errorf("an-error"))
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, map[string]interface{}{
+		"name":          "a-branch",
+		"repository_id": "a-repo",
+		"ref_branch":    "master",
+		"locked":        true,
+	})
+	d.SetId("a-repo:a-branch")
+
+	want := diag.FromErr(fmt.Errorf(" Updating lock status of branch \"a-branch\": an-error"))
+	if got := resourceGitRepositoryBranchUpdate(context.Background(), d, clients); !reflect.DeepEqual(got, want) {
+		t.Errorf("resourceGitRepositoryBranchUpdate() = %v, want %v", got, want)
+	}
+}
+
+func TestGitRepositoryBranch_SuppressSourceRefAfterImport(t *testing.T) {
+	for _, imported := range []bool{false, true} {
+		d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, nil)
+		d.SetId("00000000-0000-0000-0000-000000000000:release/1.0")
+		d.Set("imported", imported)
+
+		if got := suppressSourceRefAfterImport("ref_branch", "", "main", d); got != imported {
+			t.Errorf("suppressSourceRefAfterImport() of imported=%t = %v, want %v", imported, got, imported)
+		}
+		if suppressSourceRefAfterImport("ref_branch", "main", "develop", d) {
+			t.Errorf("suppressSourceRefAfterImport() of imported=%t suppressed a change of the source ref", imported)
+		}
+	}
+}
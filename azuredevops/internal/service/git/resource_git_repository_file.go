@@ -1,7 +1,11 @@
 package git
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
@@ -69,9 +73,28 @@ func ResourceGitRepositoryFile() *schema.Resource {
 				Description: "The file path to manage",
 			},
 			"content": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The file's content",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The file's content",
+				ExactlyOneOf: []string{"content", "content_base64"},
+			},
+			"content_base64": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The file's content encoded as base64, only a hash of the content is stored in the state",
+				ValidateFunc: validation.StringIsBase64,
+				StateFunc:    hashContentBase64,
+				ExactlyOneOf: []string{"content", "content_base64"},
+			},
+			"content_encoding": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The encoding of `content`, either \"rawText\" or \"base64Encoded\", defaults to \"rawText\"",
+				ConflictsWith: []string{"content_base64"},
+				ValidateFunc: validation.StringInSlice([]string{
+					string(git.ItemContentTypeValues.RawText),
+					string(git.ItemContentTypeValues.Base64Encoded),
+				}, false),
 			},
 			"branch": {
 				Type:        schema.TypeString,
@@ -196,15 +219,20 @@ func resourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 
+	// Binary content is read separately as the item's content is only returned as text
+	_, isBase64 := d.GetOk("content_base64")
+	isBase64Encoded := d.Get("content_encoding").(string) == string(git.ItemContentTypeValues.Base64Encoded)
+
 	// Get the repository item if it exists
+	versionDescriptor := &git.GitVersionDescriptor{
+		Version:     converter.String(shortBranchName(branch)),
+		VersionType: &git.GitVersionTypeValues.Branch,
+	}
 	repoItem, err := clients.GitReposClient.GetItem(ctx, git.GetItemArgs{
-		RepositoryId:   &repoId,
-		Path:           &file,
-		IncludeContent: converter.Bool(true),
-		VersionDescriptor: &git.GitVersionDescriptor{
-			Version:     converter.String(shortBranchName(branch)),
-			VersionType: &git.GitVersionTypeValues.Branch,
-		},
+		RepositoryId:      &repoId,
+		Path:              &file,
+		IncludeContent:    converter.Bool(!isBase64 && !isBase64Encoded),
+		VersionDescriptor: versionDescriptor,
 	})
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
@@ -214,7 +242,19 @@ func resourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, file, err)
 	}
 
-	d.Set("content", repoItem.Content)
+	if isBase64 || isBase64Encoded {
+		content, err := getRepositoryFileContentBase64(clients, repoId, file, versionDescriptor)
+		if err != nil {
+			return fmt.Errorf("Query repository item content failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, file, err)
+		}
+		if isBase64 {
+			d.Set("content_base64", hashContentBase64(content))
+		} else {
+			d.Set("content", content)
+		}
+	} else {
+		d.Set("content", repoItem.Content)
+	}
 	d.Set("repository_id", repoId)
 	d.Set("file", file)
 
@@ -251,7 +291,7 @@ func resourceGitRepositoryFileUpdate(d *schema.ResourceData, m interface{}) erro
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
-		args, err := gitRepositoryPushArgs(d, objectID, git.VersionControlChangeTypeValues.Edit)
+		args, err := gitRepositoryUpdatePushArgs(clients, d, objectID)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -393,10 +433,18 @@ func gitRepositoryPushArgs(d *schema.ResourceData, objectID string, changeType g
 	}
 
 	repo := d.Get("repository_id").(string)
-	content := d.Get("content").(string)
 	file := d.Get("file").(string)
 	branch := d.Get("branch").(string)
 
+	content := d.Get("content").(string)
+	contentType := git.ItemContentTypeValues.RawText
+	if v, ok := d.GetOk("content_base64"); ok {
+		content = v.(string)
+		contentType = git.ItemContentTypeValues.Base64Encoded
+	} else if v, ok := d.GetOk("content_encoding"); ok {
+		contentType = git.ItemContentType(v.(string))
+	}
+
 	change := git.GitChange{
 		ChangeType: &changeType,
 		Item: git.GitItem{
@@ -404,7 +452,7 @@ func gitRepositoryPushArgs(d *schema.ResourceData, objectID string, changeType g
 		},
 		NewContent: &git.ItemContent{
 			Content:     &content,
-			ContentType: &git.ItemContentTypeValues.RawText,
+			ContentType: &contentType,
 		},
 	}
 	args := &git.CreatePushArgs{
@@ -427,6 +475,63 @@ func gitRepositoryPushArgs(d *schema.ResourceData, objectID string, changeType g
 	return args, nil
 }
 
+// gitRepositoryUpdatePushArgs returns args used to push an update of the file. The state only holds a hash of
+// content_base64, so the current content of the file is pushed again if the content did not change.
+func gitRepositoryUpdatePushArgs(c *client.AggregatedClient, d *schema.ResourceData, objectID string) (*git.CreatePushArgs, error) {
+	args, err := gitRepositoryPushArgs(d, objectID, git.VersionControlChangeTypeValues.Edit)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := d.GetOk("content_base64"); !ok || d.HasChange("content_base64") {
+		return args, nil
+	}
+
+	content, err := getRepositoryFileContentBase64(c, d.Get("repository_id").(string), d.Get("file").(string), &git.GitVersionDescriptor{
+		Version:     converter.String(objectID),
+		VersionType: &git.GitVersionTypeValues.Commit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	change := (*(*args.Push.Commits)[0].Changes)[0].(git.GitChange)
+	change.NewContent.Content = &content
+	return args, nil
+}
+
+// getRepositoryFileContentBase64 returns the raw content of a repository file encoded as base64.
+func getRepositoryFileContentBase64(c *client.AggregatedClient, repoId, file string, version *git.GitVersionDescriptor) (string, error) {
+	ctx := context.Background()
+	reader, err := c.GitReposClient.GetItemContent(ctx, git.GetItemContentArgs{
+		RepositoryId:      &repoId,
+		Path:              &file,
+		VersionDescriptor: version,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err = buf.ReadFrom(reader); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// hashContentBase64 returns the SHA256 hash of the decoded base64 content, so large or binary files are not stored in the state.
+func hashContentBase64(v interface{}) string {
+	content, ok := v.(string)
+	if !ok || content == "" {
+		return ""
+	}
+	data, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		data = []byte(content)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
 // shortBranchName removes the branch prefix which some API endpoints require.
 func shortBranchName(branch string) string {
 	return strings.TrimPrefix(branch, "refs/heads/")
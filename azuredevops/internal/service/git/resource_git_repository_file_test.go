@@ -1,8 +1,18 @@
 package git
 
 import (
+	"context"
+	"io"
+	"strings"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,3 +53,122 @@ func TestSplitRepoFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestHashContentBase64(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          interface{}
+		expectedOutput string
+	}{
+		{name: "empty", input: "", expectedOutput: ""},
+		{name: "nil", input: nil, expectedOutput: ""},
+		{name: "basic", input: "YmFy", expectedOutput: "fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := hashContentBase64(tt.input)
+			require.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
+
+func TestGitRepositoryPushArgs_ContentType(t *testing.T) {
+	tests := []struct {
+		name                string
+		raw                 map[string]interface{}
+		expectedContent     string
+		expectedContentType git.ItemContentType
+	}{
+		{
+			name:                "raw text",
+			raw:                 map[string]interface{}{"content": "bar"},
+			expectedContent:     "bar",
+			expectedContentType: git.ItemContentTypeValues.RawText,
+		},
+		{
+			name:                "base64 encoded content",
+			raw:                 map[string]interface{}{"content": "YmFy", "content_encoding": "base64Encoded"},
+			expectedContent:     "YmFy",
+			expectedContentType: git.ItemContentTypeValues.Base64Encoded,
+		},
+		{
+			name:                "content_base64",
+			raw:                 map[string]interface{}{"content_base64": "YmFy"},
+			expectedContent:     "YmFy",
+			expectedContentType: git.ItemContentTypeValues.Base64Encoded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.raw["repository_id"] = "00000000-0000-0000-0000-000000000000"
+			tt.raw["file"] = "foo.bin"
+			d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFile().Schema, tt.raw)
+
+			args, err := gitRepositoryPushArgs(d, "a-commit", git.VersionControlChangeTypeValues.Add)
+			require.NoError(t, err)
+
+			change := (*(*args.Push.Commits)[0].Changes)[0].(git.GitChange)
+			require.Equal(t, tt.expectedContent, *change.NewContent.Content)
+			require.Equal(t, tt.expectedContentType, *change.NewContent.ContentType)
+		})
+	}
+}
+
+// verifies that an update of the commit message pushes the current content instead of the content hash in the state
+func TestGitRepositoryUpdatePushArgs_UnchangedContentBase64(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoId := "00000000-0000-0000-0000-000000000000"
+	r := ResourceGitRepositoryFile()
+	state := &terraform.InstanceState{
+		ID: repoId + "/foo.bin",
+		Attributes: map[string]string{
+			"id":                  repoId + "/foo.bin",
+			"repository_id":       repoId,
+			"file":                "foo.bin",
+			"content_base64":      hashContentBase64("YmFy"),
+			"branch":              "refs/heads/master",
+			"commit_message":      "Add foo.bin",
+			"overwrite_on_create": "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"repository_id":  repoId,
+		"file":           "foo.bin",
+		"content_base64": "YmFy",
+		"commit_message": "Rename the commit",
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.Nil(t, diff.Attributes["content_base64"])
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.NoError(t, err)
+
+	reposClient.
+		EXPECT().
+		GetItemContent(clients.Ctx, git.GetItemContentArgs{
+			RepositoryId: converter.String(repoId),
+			Path:         converter.String("foo.bin"),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String("a-commit"),
+				VersionType: &git.GitVersionTypeValues.Commit,
+			},
+		}).
+		Return(io.NopCloser(strings.NewReader("bar")), nil).
+		Times(1)
+
+	args, err := gitRepositoryUpdatePushArgs(clients, d, "a-commit")
+	require.NoError(t, err)
+
+	commit := (*args.Push.Commits)[0]
+	require.Equal(t, "Rename the commit", *commit.Comment)
+	change := (*commit.Changes)[0].(git.GitChange)
+	require.Equal(t, "YmFy", *change.NewContent.Content)
+	require.Equal(t, git.ItemContentTypeValues.Base64Encoded, *change.NewContent.ContentType)
+}
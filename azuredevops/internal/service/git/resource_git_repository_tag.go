@@ -0,0 +1,277 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryTag schema to manage the lifecycle of a git repository tag
+func ResourceGitRepositoryTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryTagCreate,
+		ReadContext:   resourceGitRepositoryTagRead,
+		DeleteContext: resourceGitRepositoryTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"ref_branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"ref_branch", "ref_commit_id"},
+			},
+			"ref_commit_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"ref_branch", "ref_commit_id"},
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"annotated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tagger": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	tagName := d.Get("name").(string)
+	if strings.HasPrefix(tagName, REF_TAG_PREFIX) {
+		return diag.Errorf("Tag name must be in short format without refs/tags/ prefix, got: %q", tagName)
+	}
+
+	var commitId string
+	if v, ok := d.GetOk("ref_commit_id"); ok {
+		commitId = v.(string)
+	} else {
+		objectId, err := getRefCommitId(clients, repoId, withPrefix(REF_BRANCH_PREFIX, d.Get("ref_branch").(string)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		commitId = objectId
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(" Getting repository %q: %w", repoId, err))
+		}
+
+		// the service records the authenticated identity as the tagger, so the tagger cannot be configured
+		_, err = clients.GitReposClient.CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+			Project:      converter.String(repo.Project.Id.String()),
+			RepositoryId: converter.String(repoId),
+			TagObject: &git.GitAnnotatedTag{
+				Name:    converter.String(tagName),
+				Message: converter.String(v.(string)),
+				TaggedObject: &git.GitObject{
+					ObjectId: converter.String(commitId),
+				},
+			},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(" creating annotated tag %q: %w", tagName, err))
+		}
+	} else {
+		_, err := updateRefs(clients, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String(REF_TAG_PREFIX + tagName),
+				NewObjectId: converter.String(commitId),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(" creating tag %q: %w", tagName, err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, tagName))
+	return resourceGitRepositoryTagRead(ctx, d, m)
+}
+
+func resourceGitRepositoryTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, tagName, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gotRef, err := getRef(clients, repoId, REF_TAG_PREFIX+tagName)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(" Reading tag %q: %w", tagName, err))
+	}
+	if gotRef == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", tagName)
+	d.Set("repository_id", repoId)
+	d.Set("object_id", gotRef.ObjectId)
+
+	// Only annotated tags are peeled to the tagged commit
+	if gotRef.PeeledObjectId == nil {
+		d.Set("annotated", false)
+		d.Set("commit_id", gotRef.ObjectId)
+		d.Set("message", nil)
+		d.Set("tagger", nil)
+		setImportedTagRef(d, *gotRef.ObjectId)
+		return nil
+	}
+
+	repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" Getting repository %q: %w", repoId, err))
+	}
+
+	annotatedTag, err := clients.GitReposClient.GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+		Project:      converter.String(repo.Project.Id.String()),
+		RepositoryId: converter.String(repoId),
+		ObjectId:     gotRef.ObjectId,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" Reading annotated tag %q: %w", tagName, err))
+	}
+
+	d.Set("annotated", true)
+	d.Set("commit_id", gotRef.PeeledObjectId)
+	d.Set("message", strings.TrimSuffix(converter.ToString(annotatedTag.Message, ""), "\n"))
+	d.Set("tagger", flattenGitUserDate(annotatedTag.TaggedBy))
+	setImportedTagRef(d, *gotRef.PeeledObjectId)
+	return nil
+}
+
+func resourceGitRepositoryTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, tagName, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gotRef, err := getRef(clients, repoId, REF_TAG_PREFIX+tagName)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" Getting tag %q: %w", tagName, err))
+	}
+	if gotRef == nil {
+		return nil
+	}
+
+	_, err = updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(REF_TAG_PREFIX + tagName),
+			OldObjectId: gotRef.ObjectId,
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" Deleting tag %q: %w", tagName, err))
+	}
+
+	return nil
+}
+
+// setImportedTagRef sets the tagged commit as the source ref if no source ref is known, e.g. after an import.
+func setImportedTagRef(d *schema.ResourceData, commitId string) {
+	_, hasBranch := d.GetOk("ref_branch")
+	_, hasCommit := d.GetOk("ref_commit_id")
+	if !hasBranch && !hasCommit {
+		d.Set("ref_commit_id", commitId)
+	}
+}
+
+func flattenGitUserDate(userDate *git.GitUserDate) []interface{} {
+	if userDate == nil {
+		return nil
+	}
+
+	date := ""
+	if userDate.Date != nil {
+		date = userDate.Date.Time.Format(time.RFC3339)
+	}
+	return []interface{}{map[string]interface{}{
+		"name":  converter.ToString(userDate.Name, ""),
+		"email": converter.ToString(userDate.Email, ""),
+		"date":  date,
+	}}
+}
@@ -0,0 +1,180 @@
+//go:build (all || git || resource_git_repository_tag) && (!exclude_git || !exclude_resource_git_repository_tag)
+// +build all git resource_git_repository_tag
+// +build !exclude_git !exclude_resource_git_repository_tag
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRepositoryTag_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		d   *schema.ResourceData
+		m   interface{}
+	}
+	tests := []struct {
+		name string
+		args func(g *azdosdkmocks.MockGitClient) args
+		want diag.Diagnostics
+	}{
+		{
+			"When no message is given, a lightweight tag is created and refs update does not swallow error",
+			func(g *azdosdkmocks.MockGitClient) args {
+				clients := &client.AggregatedClient{
+					GitReposClient: g,
+					Ctx:            context.Background(),
+				}
+				d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+				d.Set("ref_commit_id", "a-commit")
+				d.Set("name", "v1.0.0")
+				d.Set("repository_id", "a-repo")
+
+				g.EXPECT().
+					UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+						RefUpdates: &[]git.GitRefUpdate{{
+							Name:        converter.String("refs/tags/v1.0.0"),
+							NewObjectId: converter.String("a-commit"),
+							OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+						}},
+						RepositoryId: converter.String("a-repo"),
+					}).
+					Return(nil, fmt.Errorf("an-error"))
+				return args{
+					context.Background(),
+					d,
+					clients,
+				}
+			},
+			diag.FromErr(fmt.Errorf(" creating tag \"v1.0.0\": an-error")),
+		},
+		{
+			"When a message is given, an annotated tag is created and does not swallow error",
+			func(g *azdosdkmocks.MockGitClient) args {
+				clients := &client.AggregatedClient{
+					GitReposClient: g,
+					Ctx:            context.Background(),
+				}
+				d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+				d.Set("ref_commit_id", "a-commit")
+				d.Set("name", "v1.0.0")
+				d.Set("repository_id", "a-repo")
+				d.Set("message", "a-message")
+
+				projectId := uuid.New()
+				g.EXPECT().
+					GetRepository(clients.Ctx, git.GetRepositoryArgs{
+						RepositoryId: converter.String("a-repo"),
+					}).
+					Return(&git.GitRepository{
+						Project: &core.TeamProjectReference{Id: &projectId},
+					}, nil)
+
+				g.EXPECT().
+					CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+						Project:      converter.String(projectId.String()),
+						RepositoryId: converter.String("a-repo"),
+						TagObject: &git.GitAnnotatedTag{
+							Name:    converter.String("v1.0.0"),
+							Message: converter.String("a-message"),
+							TaggedObject: &git.GitObject{
+								ObjectId: converter.String("a-commit"),
+							},
+						},
+					}).
+					Return(nil, fmt.Errorf("an-error"))
+				return args{
+					context.Background(),
+					d,
+					clients,
+				}
+			},
+			diag.FromErr(fmt.Errorf(" creating annotated tag \"v1.0.0\": an-error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+			testArgs := tt.args(gitClient)
+
+			if got := resourceGitRepositoryTagCreate(testArgs.ctx, testArgs.d, testArgs.m); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceGitRepositoryTagCreate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitRepositoryTag_Read_Lightweight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String("a-repo"),
+			Filter:       converter.String("tags/v1.0.0"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/v1.0.0-rc"), ObjectId: converter.String("another-commit")},
+				{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String("a-commit")},
+			},
+		}, nil)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId("a-repo:v1.0.0")
+
+	diags := resourceGitRepositoryTagRead(context.Background(), d, clients)
+	require.Nil(t, diags)
+	require.Equal(t, "v1.0.0", d.Get("name"))
+	require.Equal(t, "a-repo", d.Get("repository_id"))
+	require.Equal(t, false, d.Get("annotated"))
+	require.Equal(t, "a-commit", d.Get("commit_id"))
+	require.Equal(t, "a-commit", d.Get("ref_commit_id"))
+}
+
+func TestGitRepositoryTag_Read_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	gitClient.EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{}, nil)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId("a-repo:v1.0.0")
+
+	diags := resourceGitRepositoryTagRead(context.Background(), d, clients)
+	require.Nil(t, diags)
+	require.Equal(t, "", d.Id())
+}
@@ -9,12 +9,15 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -304,3 +307,307 @@ func TestGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 
 	resourceGitRepositoryRead(resourceData, clients)
 }
+
+// verifies that the most recently deleted repository with a matching name is restored
+// from the recycle bin instead of creating a new repository
+func TestGitRepo_Create_RestoresFromRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, nil)
+	resourceData.Set("name", *testGitRepository.Name)
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("features", []interface{}{map[string]interface{}{"restore": true}})
+	configureCleanInitialization(resourceData)
+
+	olderRepoID := uuid.New()
+	now := time.Now()
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{Project: converter.String(testRepoProjectID.String())}).
+		Return(&[]git.GitDeletedRepository{
+			{Id: &olderRepoID, Name: converter.String("reponame"), DeletedDate: &azuredevops.Time{Time: now.Add(-time.Hour)}},
+			{Id: &testRepoID, Name: converter.String("reponame"), DeletedDate: &azuredevops.Time{Time: now}},
+			{Id: converter.UUID(uuid.New().String()), Name: converter.String("another-repo"), DeletedDate: &azuredevops.Time{Time: now}},
+		}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		RestoreRepositoryFromRecycleBin(clients.Ctx, git.RestoreRepositoryFromRecycleBinArgs{
+			Project:           converter.String(testRepoProjectID.String()),
+			RepositoryId:      &testRepoID,
+			RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+		}).
+		Return(&testGitRepository, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&testGitRepository, nil).
+		Times(1)
+
+	reposClient.EXPECT().CreateRepository(gomock.Any(), gomock.Any()).Times(0)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+func TestGitRepo_Delete_PurgesFromRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, nil)
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("features", []interface{}{map[string]interface{}{"permanent_delete": true}})
+
+	reposClient.
+		EXPECT().
+		DeleteRepository(clients.Ctx, git.DeleteRepositoryArgs{RepositoryId: &testRepoID}).
+		Return(nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		DeleteRepositoryFromRecycleBin(clients.Ctx, git.DeleteRepositoryFromRecycleBinArgs{
+			Project:      converter.String(testRepoProjectID.String()),
+			RepositoryId: &testRepoID,
+		}).
+		Return(fmt.Errorf("DeleteRepositoryFromRecycleBin() Failed")).
+		Times(1)
+
+	err := resourceGitRepositoryDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteRepositoryFromRecycleBin() Failed")
+}
+
+// verifies that a temporary service endpoint is used to authenticate an import with credentials
+// and that it is removed again if the import request can not be created
+func TestGitRepo_Import_WithCredentials_RemovesServiceEndpointOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:        reposClient,
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointID := uuid.New()
+	serviceEndpointClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.CreateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, "git", *args.Endpoint.Type)
+			require.Equal(t, "https://example.com/repo.git", *args.Endpoint.Url)
+			require.Equal(t, "UsernamePassword", *args.Endpoint.Authorization.Scheme)
+			require.Equal(t, "a-user", (*args.Endpoint.Authorization.Parameters)["username"])
+			require.Equal(t, "a-password", (*args.Endpoint.Authorization.Parameters)["password"])
+			return &serviceendpoint.ServiceEndpoint{Id: &serviceEndpointID}, nil
+		}).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		CreateImportRequest(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreateImportRequestArgs) (*git.GitImportRequest, error) {
+			require.Equal(t, serviceEndpointID, *args.ImportRequest.Parameters.ServiceEndpointId)
+			require.True(t, *args.ImportRequest.Parameters.DeleteServiceEndpointAfterImportIsDone)
+			return nil, errors.New("CreateImportRequest() Failed")
+		}).
+		Times(1)
+
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: &serviceEndpointID,
+			ProjectIds: &[]string{testRepoProjectID.String()},
+		}).
+		Return(nil).
+		Times(1)
+
+	err := importGitRepository(clients, &testGitRepository, &testRepoProjectID, &repoInitializationMeta{
+		initType:   "Import",
+		sourceType: "Git",
+		sourceURL:  "https://example.com/repo.git",
+		username:   "a-user",
+		password:   "a-password",
+	}, time.Minute)
+	require.Contains(t, err.Error(), "CreateImportRequest() Failed")
+}
+
+// verifies that a failed import request is reported with the error message of the service
+func TestGitRepo_Import_ReportsFailedImportRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	importRequestID := 7
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+			Project:         converter.String(testRepoProjectID.String()),
+			RepositoryId:    testGitRepository.Name,
+			ImportRequestId: &importRequestID,
+		}).
+		Return(&git.GitImportRequest{
+			ImportRequestId: &importRequestID,
+			Status:          &git.GitAsyncOperationStatusValues.Failed,
+			DetailedStatus: &git.GitImportStatusDetail{
+				ErrorMessage: converter.String("authentication failed"),
+			},
+		}, nil).
+		Times(1)
+
+	err := waitForImport(clients, &git.GitImportRequest{ImportRequestId: &importRequestID}, testRepoProjectID.String(), *testGitRepository.Name, time.Minute)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "import request 7 is failed: authentication failed")
+}
+
+// verifies that the configured branches of a fork are synchronized with its parent and
+// that a failed synchronization is reported
+func TestGitRepo_ForkSync_ReportsFailedSyncRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	parentRepoID := uuid.New()
+	parentProjectID := uuid.New()
+	operationID := 3
+	reposClient.
+		EXPECT().
+		CreateForkSyncRequest(clients.Ctx, git.CreateForkSyncRequestArgs{
+			SyncParams: &git.GitForkSyncRequestParameters{
+				Source: &git.GlobalGitRepositoryKey{
+					ProjectId:    &parentProjectID,
+					RepositoryId: &parentRepoID,
+				},
+				SourceToTargetRefs: &[]git.SourceToTargetRef{
+					{SourceRef: converter.String("refs/heads/main"), TargetRef: converter.String("refs/heads/main")},
+				},
+			},
+			RepositoryNameOrId: converter.String(testRepoID.String()),
+			Project:            converter.String(testRepoProjectID.String()),
+		}).
+		Return(&git.GitForkSyncRequest{OperationId: &operationID}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		GetForkSyncRequest(clients.Ctx, git.GetForkSyncRequestArgs{
+			RepositoryNameOrId:  converter.String(testRepoID.String()),
+			ForkSyncOperationId: &operationID,
+			Project:             converter.String(testRepoProjectID.String()),
+		}).
+		Return(&git.GitForkSyncRequest{
+			OperationId: &operationID,
+			Status:      &git.GitAsyncOperationStatusValues.Failed,
+			DetailedStatus: &git.GitForkOperationStatusDetail{
+				ErrorMessage: converter.String("conflict"),
+			},
+		}, nil).
+		Times(1)
+
+	parentRepo := &git.GitRepositoryRef{
+		Id:      &parentRepoID,
+		Project: &core.TeamProjectReference{Id: &parentProjectID},
+	}
+	err := syncGitRepositoryFork(clients, testRepoID.String(), testRepoProjectID.String(), parentRepo, []string{"refs/heads/main"}, time.Minute)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "fork sync request 3 is failed: conflict")
+}
+
+func TestGitRepo_Read_ReportsDriftedForkBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	parentRepoID := uuid.New()
+	fork := testGitRepository
+	fork.IsFork = converter.Bool(true)
+	fork.ParentRepository = &git.GitRepositoryRef{Id: &parentRepoID}
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&fork, nil).
+		Times(1)
+
+	heads := map[string][]git.GitRef{
+		parentRepoID.String(): {
+			{Name: converter.String("refs/heads/main"), ObjectId: converter.String("commit-1")},
+			{Name: converter.String("refs/heads/release"), ObjectId: converter.String("commit-3")},
+			{Name: converter.String("refs/heads/hotfix"), ObjectId: converter.String("commit-4")},
+		},
+		testRepoID.String(): {
+			{Name: converter.String("refs/heads/main"), ObjectId: converter.String("commit-1")},
+			{Name: converter.String("refs/heads/release"), ObjectId: converter.String("commit-2")},
+			{Name: converter.String("refs/heads/feature"), ObjectId: converter.String("commit-5")},
+		},
+	}
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+			require.Equal(t, "heads/", *args.Filter)
+			return &git.GetRefsResponseValue{Value: heads[*args.RepositoryId]}, nil
+		}).
+		Times(2)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id": testRepoProjectID.String(),
+		"name":       "RepoName",
+		"fork_sync": []interface{}{
+			map[string]interface{}{
+				"branches": []interface{}{"main", "refs/heads/release", "hotfix", "feature"},
+				"triggers": map[string]interface{}{"sync": "1"},
+			},
+		},
+	})
+	resourceData.SetId(testRepoID.String())
+
+	err := resourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []interface{}{"refs/heads/release", "hotfix"}, resourceData.Get("fork_sync_drifted_branches").(*schema.Set).List())
+	require.Len(t, resourceData.Get("fork_sync.0.branches").(*schema.Set).List(), 4)
+	require.Equal(t, "1", resourceData.Get("fork_sync.0.triggers.sync"))
+}
+
+func TestGitRepo_ExpandForkSyncBranches_AddsPrefix(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"fork_sync": []interface{}{
+			map[string]interface{}{
+				"branches": []interface{}{"main", "refs/heads/release"},
+			},
+		},
+	})
+
+	require.ElementsMatch(t, []string{"refs/heads/main", "refs/heads/release"}, expandForkSyncBranches(resourceData))
+}
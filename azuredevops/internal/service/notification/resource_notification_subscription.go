@@ -0,0 +1,344 @@
+package notification
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/notification"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/notificationextras"
+)
+
+var emailChannelTypes = []string{"EmailHtml", "EmailPlaintext"}
+
+// ResourceNotificationSubscription schema and implementation for notification subscriptions of teams and groups
+func ResourceNotificationSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNotificationSubscriptionCreate,
+		Read:   resourceNotificationSubscriptionRead,
+		Update: resourceNotificationSubscriptionUpdate,
+		Delete: resourceNotificationSubscriptionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"subscriber_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The identity ID of the team or group receiving the notifications",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The ID of the project the events must be published from. If not specified, events of the whole organization are matched",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"event_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The event type matched by the subscription, e.g. `ms.vss-build.build-completed-event`",
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"logical_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"And", "Or"}, false),
+							Description:  "The operator combining the clause with the previous clause. Ignored for the first clause",
+						},
+					},
+				},
+			},
+			"channel_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "EmailHtml",
+				ValidateFunc: validation.StringInSlice([]string{"EmailHtml", "EmailPlaintext", "User", "Group"}, false),
+				Description:  "The channel delivering the notifications",
+			},
+			"email_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "A custom email address receiving the notifications. Only valid for email channels",
+			},
+			"block_user_opt_out": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevent members of the team or group from opting out of the subscription",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNotificationSubscriptionCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	channel, err := expandNotificationSubscriptionChannel(d)
+	if err != nil {
+		return err
+	}
+
+	createParameters := &notificationextras.NotificationSubscriptionCreateParameters{
+		Channel:     channel,
+		Description: converter.String(d.Get("description").(string)),
+		Filter:      expandNotificationSubscriptionFilter(d),
+		Subscriber:  &webapi.IdentityRef{Id: converter.String(d.Get("subscriber_id").(string))},
+	}
+	if v, ok := d.GetOk("project_id"); ok {
+		createParameters.Scope = &notification.SubscriptionScope{Id: converter.UUID(v.(string))}
+	}
+
+	subscription, err := clients.NotificationClientExtras.CreateSubscription(clients.Ctx, notificationextras.CreateSubscriptionArgs{
+		CreateParameters: createParameters,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating notification subscription: %+v", err)
+	}
+	d.SetId(*subscription.Id)
+
+	// the admin settings and the status can only be set by updating the subscription
+	if d.Get("block_user_opt_out").(bool) || !d.Get("enabled").(bool) {
+		if _, err := clients.NotificationClientExtras.UpdateSubscription(clients.Ctx, notificationextras.UpdateSubscriptionArgs{
+			SubscriptionId:   subscription.Id,
+			UpdateParameters: expandNotificationSubscriptionSettings(d),
+		}); err != nil {
+			return fmt.Errorf(" updating settings of notification subscription %s: %+v", d.Id(), err)
+		}
+	}
+
+	return resourceNotificationSubscriptionRead(d, m)
+}
+
+func resourceNotificationSubscriptionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	subscription, err := clients.NotificationClientExtras.GetSubscription(clients.Ctx, notificationextras.GetSubscriptionArgs{
+		SubscriptionId: converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading notification subscription %s: %+v", d.Id(), err)
+	}
+	if subscription.Status != nil && *subscription.Status == notification.SubscriptionStatusValues.PendingDeletion {
+		d.SetId("")
+		return nil
+	}
+
+	flattenNotificationSubscription(d, subscription)
+	return nil
+}
+
+func resourceNotificationSubscriptionUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	channel, err := expandNotificationSubscriptionChannel(d)
+	if err != nil {
+		return err
+	}
+
+	updateParameters := expandNotificationSubscriptionSettings(d)
+	updateParameters.Channel = channel
+	updateParameters.Description = converter.String(d.Get("description").(string))
+	updateParameters.Filter = expandNotificationSubscriptionFilter(d)
+
+	if _, err := clients.NotificationClientExtras.UpdateSubscription(clients.Ctx, notificationextras.UpdateSubscriptionArgs{
+		SubscriptionId:   converter.String(d.Id()),
+		UpdateParameters: updateParameters,
+	}); err != nil {
+		return fmt.Errorf(" updating notification subscription %s: %+v", d.Id(), err)
+	}
+
+	return resourceNotificationSubscriptionRead(d, m)
+}
+
+func resourceNotificationSubscriptionDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := clients.NotificationClientExtras.DeleteSubscription(clients.Ctx, notificationextras.DeleteSubscriptionArgs{
+		SubscriptionId: converter.String(d.Id()),
+	}); err != nil {
+		return fmt.Errorf(" deleting notification subscription %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandNotificationSubscriptionChannel(d *schema.ResourceData) (*notificationextras.SubscriptionChannel, error) {
+	channel := &notificationextras.SubscriptionChannel{
+		Type: converter.String(d.Get("channel_type").(string)),
+	}
+	if v, ok := d.GetOk("email_address"); ok {
+		if !slices.Contains(emailChannelTypes, *channel.Type) {
+			return nil, fmt.Errorf(" `email_address` can only be set for the channel types %s", strings.Join(emailChannelTypes, ", "))
+		}
+		channel.Address = converter.String(v.(string))
+		channel.UseCustomAddress = converter.Bool(true)
+	}
+	return channel, nil
+}
+
+func expandNotificationSubscriptionFilter(d *schema.ResourceData) *notificationextras.SubscriptionFilter {
+	clauses := []notification.ExpressionFilterClause{}
+	for i, raw := range d.Get("criteria").([]interface{}) {
+		criteria := raw.(map[string]interface{})
+		clause := notification.ExpressionFilterClause{
+			FieldName: converter.String(criteria["field_name"].(string)),
+			Index:     converter.Int(i + 1),
+			Operator:  converter.String(criteria["operator"].(string)),
+			Value:     converter.String(criteria["value"].(string)),
+		}
+		if i > 0 {
+			logicalOperator := criteria["logical_operator"].(string)
+			if logicalOperator == "" {
+				logicalOperator = "And"
+			}
+			clause.LogicalOperator = &logicalOperator
+		}
+		clauses = append(clauses, clause)
+	}
+
+	return &notificationextras.SubscriptionFilter{
+		Type:      converter.String("Expression"),
+		EventType: converter.String(d.Get("event_type").(string)),
+		Criteria: &notification.ExpressionFilterModel{
+			Clauses:       &clauses,
+			Groups:        &[]notification.ExpressionFilterGroup{},
+			MaxGroupLevel: converter.Int(0),
+		},
+	}
+}
+
+func expandNotificationSubscriptionSettings(d *schema.ResourceData) *notificationextras.NotificationSubscriptionUpdateParameters {
+	status := notification.SubscriptionStatusValues.Enabled
+	if !d.Get("enabled").(bool) {
+		status = notification.SubscriptionStatusValues.Disabled
+	}
+	return &notificationextras.NotificationSubscriptionUpdateParameters{
+		AdminSettings: &notification.SubscriptionAdminSettings{
+			BlockUserOptOut: converter.Bool(d.Get("block_user_opt_out").(bool)),
+		},
+		Status: &status,
+	}
+}
+
+func flattenNotificationSubscription(d *schema.ResourceData, subscription *notificationextras.NotificationSubscription) {
+	d.Set("description", subscription.Description)
+	if subscription.Subscriber != nil {
+		d.Set("subscriber_id", subscription.Subscriber.Id)
+	}
+	if subscription.Scope != nil && subscription.Scope.Id != nil {
+		if _, ok := d.GetOk("project_id"); ok || strings.EqualFold(converter.ToString(subscription.Scope.Type, ""), "project") {
+			d.Set("project_id", subscription.Scope.Id.String())
+		}
+	}
+
+	if subscription.Channel != nil {
+		d.Set("channel_type", subscription.Channel.Type)
+		if converter.ToBool(subscription.Channel.UseCustomAddress, false) {
+			d.Set("email_address", subscription.Channel.Address)
+		} else {
+			d.Set("email_address", "")
+		}
+	}
+
+	if subscription.Filter != nil {
+		d.Set("event_type", subscription.Filter.EventType)
+		d.Set("criteria", flattenNotificationSubscriptionCriteria(subscription.Filter.Criteria))
+	}
+
+	blockUserOptOut := false
+	if subscription.AdminSettings != nil {
+		blockUserOptOut = converter.ToBool(subscription.AdminSettings.BlockUserOptOut, false)
+	}
+	d.Set("block_user_opt_out", blockUserOptOut)
+
+	if subscription.Status != nil {
+		d.Set("status", string(*subscription.Status))
+		d.Set("enabled", *subscription.Status == notification.SubscriptionStatusValues.Enabled ||
+			*subscription.Status == notification.SubscriptionStatusValues.EnabledOnProbation)
+	}
+	d.Set("status_message", subscription.StatusMessage)
+}
+
+func flattenNotificationSubscriptionCriteria(criteria *notification.ExpressionFilterModel) []interface{} {
+	if criteria == nil || criteria.Clauses == nil {
+		return []interface{}{}
+	}
+
+	clauses := make([]notification.ExpressionFilterClause, len(*criteria.Clauses))
+	copy(clauses, *criteria.Clauses)
+	sort.SliceStable(clauses, func(i, j int) bool {
+		return clauses[i].Index != nil && (clauses[j].Index == nil || *clauses[i].Index < *clauses[j].Index)
+	})
+
+	results := make([]interface{}, 0, len(clauses))
+	for _, clause := range clauses {
+		results = append(results, map[string]interface{}{
+			"field_name":       converter.ToString(clause.FieldName, ""),
+			"operator":         converter.ToString(clause.Operator, ""),
+			"value":            converter.ToString(clause.Value, ""),
+			"logical_operator": converter.ToString(clause.LogicalOperator, ""),
+		})
+	}
+	return results
+}
@@ -0,0 +1,220 @@
+//go:build (all || notification || resource_notification_subscription) && !exclude_resource_notification_subscription
+// +build all notification resource_notification_subscription
+// +build !exclude_resource_notification_subscription
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/notification"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/notificationextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testNotificationSubscriptionID = "12345"
+var testNotificationSubscriberID = uuid.New()
+var testNotificationProjectID = uuid.New()
+
+func getNotificationSubscriptionResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	config := map[string]interface{}{
+		"subscriber_id": testNotificationSubscriberID.String(),
+		"project_id":    testNotificationProjectID.String(),
+		"description":   "Build fails on main",
+		"event_type":    "ms.vss-build.build-completed-event",
+		"criteria": []interface{}{
+			map[string]interface{}{"field_name": "Status", "operator": "=", "value": "Failed"},
+			map[string]interface{}{"field_name": "Branch", "operator": "=", "value": "refs/heads/main"},
+		},
+		"email_address": "team@example.com",
+	}
+	for k, v := range raw {
+		config[k] = v
+	}
+	return schema.TestResourceDataRaw(t, ResourceNotificationSubscription().Schema, config)
+}
+
+func TestNotificationSubscription_Create_SendsFilterAndChannel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockNotificationextrasClient(ctrl)
+	clients := &client.AggregatedClient{NotificationClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := getNotificationSubscriptionResourceData(t, nil)
+
+	mockClient.
+		EXPECT().
+		CreateSubscription(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args notificationextras.CreateSubscriptionArgs) (*notificationextras.NotificationSubscription, error) {
+			params := args.CreateParameters
+			require.Equal(t, testNotificationSubscriberID.String(), *params.Subscriber.Id)
+			require.Equal(t, testNotificationProjectID, *params.Scope.Id)
+			require.Equal(t, "Expression", *params.Filter.Type)
+			require.Equal(t, "ms.vss-build.build-completed-event", *params.Filter.EventType)
+			clauses := *params.Filter.Criteria.Clauses
+			require.Len(t, clauses, 2)
+			require.Nil(t, clauses[0].LogicalOperator)
+			require.Equal(t, 1, *clauses[0].Index)
+			require.Equal(t, "And", *clauses[1].LogicalOperator)
+			require.Equal(t, "EmailHtml", *params.Channel.Type)
+			require.Equal(t, "team@example.com", *params.Channel.Address)
+			require.True(t, *params.Channel.UseCustomAddress)
+			return nil, errors.New("CreateSubscription() Failed")
+		}).
+		Times(1)
+
+	err := ResourceNotificationSubscription().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateSubscription() Failed")
+}
+
+func TestNotificationSubscription_Create_UpdatesSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockNotificationextrasClient(ctrl)
+	clients := &client.AggregatedClient{NotificationClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := getNotificationSubscriptionResourceData(t, map[string]interface{}{
+		"block_user_opt_out": true,
+		"enabled":            false,
+	})
+
+	mockClient.
+		EXPECT().
+		CreateSubscription(clients.Ctx, gomock.Any()).
+		Return(&notificationextras.NotificationSubscription{Id: &testNotificationSubscriptionID}, nil).
+		Times(1)
+
+	disabled := notification.SubscriptionStatusValues.Disabled
+	mockClient.
+		EXPECT().
+		UpdateSubscription(clients.Ctx, notificationextras.UpdateSubscriptionArgs{
+			SubscriptionId: &testNotificationSubscriptionID,
+			UpdateParameters: &notificationextras.NotificationSubscriptionUpdateParameters{
+				AdminSettings: &notification.SubscriptionAdminSettings{BlockUserOptOut: converter.Bool(true)},
+				Status:        &disabled,
+			},
+		}).
+		Return(nil, errors.New("UpdateSubscription() Failed")).
+		Times(1)
+
+	err := ResourceNotificationSubscription().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateSubscription() Failed")
+}
+
+func TestNotificationSubscription_Create_RejectsEmailAddressForNonEmailChannel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockNotificationextrasClient(ctrl)
+	clients := &client.AggregatedClient{NotificationClientExtras: mockClient, Ctx: context.Background()}
+	mockClient.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).Times(0)
+
+	resourceData := getNotificationSubscriptionResourceData(t, map[string]interface{}{
+		"channel_type": "Group",
+	})
+
+	err := ResourceNotificationSubscription().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "`email_address` can only be set")
+}
+
+func TestNotificationSubscription_Read_FlattensSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockNotificationextrasClient(ctrl)
+	clients := &client.AggregatedClient{NotificationClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceNotificationSubscription().Schema, nil)
+	resourceData.SetId(testNotificationSubscriptionID)
+
+	status := notification.SubscriptionStatusValues.EnabledOnProbation
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, notificationextras.GetSubscriptionArgs{SubscriptionId: &testNotificationSubscriptionID}).
+		Return(&notificationextras.NotificationSubscription{
+			Id:            &testNotificationSubscriptionID,
+			Description:   converter.String("Build fails on main"),
+			AdminSettings: &notification.SubscriptionAdminSettings{BlockUserOptOut: converter.Bool(true)},
+			Channel:       &notificationextras.SubscriptionChannel{Type: converter.String("User")},
+			Filter: &notificationextras.SubscriptionFilter{
+				Type:      converter.String("Expression"),
+				EventType: converter.String("ms.vss-build.build-completed-event"),
+				Criteria: &notification.ExpressionFilterModel{
+					Clauses: &[]notification.ExpressionFilterClause{
+						{FieldName: converter.String("Branch"), Index: converter.Int(2), LogicalOperator: converter.String("Or"), Operator: converter.String("="), Value: converter.String("refs/heads/main")},
+						{FieldName: converter.String("Status"), Index: converter.Int(1), Operator: converter.String("="), Value: converter.String("Failed")},
+					},
+				},
+			},
+			Scope:      &notification.SubscriptionScope{Id: &testNotificationProjectID, Type: converter.String("project")},
+			Status:     &status,
+			Subscriber: &webapi.IdentityRef{Id: converter.String(testNotificationSubscriberID.String())},
+		}, nil).
+		Times(1)
+
+	err := ResourceNotificationSubscription().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testNotificationSubscriberID.String(), resourceData.Get("subscriber_id"))
+	require.Equal(t, testNotificationProjectID.String(), resourceData.Get("project_id"))
+	require.Equal(t, "User", resourceData.Get("channel_type"))
+	require.Equal(t, "", resourceData.Get("email_address"))
+	require.Equal(t, "Status", resourceData.Get("criteria.0.field_name"))
+	require.Equal(t, "Or", resourceData.Get("criteria.1.logical_operator"))
+	require.Equal(t, true, resourceData.Get("block_user_opt_out"))
+	require.Equal(t, true, resourceData.Get("enabled"))
+	require.Equal(t, "enabledOnProbation", resourceData.Get("status"))
+}
+
+func TestNotificationSubscription_Read_RemovesDeletedSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockNotificationextrasClient(ctrl)
+	clients := &client.AggregatedClient{NotificationClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceNotificationSubscription().Schema, nil)
+	resourceData.SetId(testNotificationSubscriptionID)
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := ResourceNotificationSubscription().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestNotificationSubscription_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockNotificationextrasClient(ctrl)
+	clients := &client.AggregatedClient{NotificationClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceNotificationSubscription().Schema, nil)
+	resourceData.SetId(testNotificationSubscriptionID)
+
+	mockClient.
+		EXPECT().
+		DeleteSubscription(clients.Ctx, notificationextras.DeleteSubscriptionArgs{SubscriptionId: &testNotificationSubscriptionID}).
+		Return(errors.New("DeleteSubscription() Failed")).
+		Times(1)
+
+	err := ResourceNotificationSubscription().Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteSubscription() Failed")
+}
@@ -0,0 +1,51 @@
+//go:build (all || permissions || resource_secure_file_permissions) && (!exclude_permissions || !exclude_resource_secure_file_permissions)
+// +build all permissions resource_secure_file_permissions
+// +build !exclude_permissions !exclude_resource_secure_file_permissions
+
+package permissions
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var secureFileID = "7f35b5a4-6bd4-4fd1-9f47-2bd0fc3cbe8f"
+var secureFileToken = fmt.Sprintf("Library/%s/SecureFile/%s", projectID, secureFileID)
+
+func TestSecureFilePermissions_CreateSecureFileToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getSecureFilePermissionsResource(t, projectID, secureFileID)
+	token, err = createSecureFileToken(d, nil)
+	assert.NotEmpty(t, token)
+	assert.Nil(t, err)
+	assert.Equal(t, secureFileToken, token)
+
+	d = getSecureFilePermissionsResource(t, "", "")
+	token, err = createSecureFileToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getSecureFilePermissionsResource(t *testing.T, projectID string, secureFileID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceSecureFilePermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if secureFileID != "" {
+		d.Set("secure_file_id", secureFileID)
+	}
+	return d
+}
@@ -0,0 +1,113 @@
+package release
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+)
+
+// DataReleaseDefinition schema and implementation for release definition data source
+func DataReleaseDefinition() *schema.Resource {
+	dataSchema := computedSchema(ResourceReleaseDefinition().Schema)
+	dataSchema["project_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	dataSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	dataSchema["path"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      `\`,
+		ValidateFunc: validate.Path,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceReleaseDefinitionRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceReleaseDefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+	path := d.Get("path").(string)
+
+	definitions, err := clients.ReleaseClient.GetReleaseDefinitions(clients.Ctx, release.GetReleaseDefinitionsArgs{
+		Project:          converter.String(projectID),
+		SearchText:       converter.String(name),
+		IsExactNameMatch: converter.Bool(true),
+		Path:             converter.String(path),
+	})
+	if err != nil {
+		return diag.Errorf(" finding release definition %s in project %s: %+v", name, projectID, err)
+	}
+
+	var matches []release.ReleaseDefinition
+	if definitions != nil {
+		for _, definition := range definitions.Value {
+			if strings.EqualFold(converter.ToString(definition.Name, ""), name) &&
+				strings.EqualFold(converter.ToString(definition.Path, `\`), path) {
+				matches = append(matches, definition)
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf(" Release Definition with name %s does not exist in project %s in %s path", name, projectID, path)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf(" Multiple release definitions with name %s found in project %s in %s path", name, projectID, path)
+	}
+
+	releaseDefinition, err := clients.ReleaseClient.GetReleaseDefinition(clients.Ctx, release.GetReleaseDefinitionArgs{
+		Project:      converter.String(projectID),
+		DefinitionId: matches[0].Id,
+	})
+	if err != nil {
+		return diag.Errorf(" reading release definition %d: %+v", *matches[0].Id, err)
+	}
+
+	d.SetId(strconv.Itoa(*releaseDefinition.Id))
+	if err := flattenReleaseDefinition(d, releaseDefinition, projectID); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// computedSchema returns a copy of the resource schema in which all attributes are computed
+func computedSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(resourceSchema))
+	for key, attribute := range resourceSchema {
+		computed := &schema.Schema{
+			Type:      attribute.Type,
+			Computed:  true,
+			Sensitive: attribute.Sensitive,
+		}
+		switch elem := attribute.Elem.(type) {
+		case *schema.Resource:
+			computed.Elem = &schema.Resource{Schema: computedSchema(elem.Schema)}
+		case *schema.Schema:
+			computed.Elem = &schema.Schema{Type: elem.Type}
+		}
+		result[key] = computed
+	}
+	return result
+}
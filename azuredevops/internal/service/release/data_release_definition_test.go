@@ -0,0 +1,92 @@
+//go:build (all || data_sources || data_release_definition) && (!exclude_data_sources || !exclude_data_release_definition)
+// +build all data_sources data_release_definition
+// +build !exclude_data_sources !exclude_data_release_definition
+
+package release
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceReleaseDefinition_Read_MatchesNameAndPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetReleaseDefinitions(clients.Ctx, release.GetReleaseDefinitionsArgs{
+			Project:          converter.String(testReleaseDefinitionProjectID),
+			SearchText:       converter.String("release"),
+			IsExactNameMatch: converter.Bool(true),
+			Path:             converter.String(`\folder`),
+		}).
+		Return(&release.GetReleaseDefinitionsResponseValue{
+			Value: []release.ReleaseDefinition{
+				{Id: converter.Int(6), Name: converter.String("release"), Path: converter.String(`\folder\sub`)},
+				{Id: converter.Int(7), Name: converter.String("Release"), Path: converter.String(`\folder`)},
+			},
+		}, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		GetReleaseDefinition(clients.Ctx, release.GetReleaseDefinitionArgs{
+			Project:      converter.String(testReleaseDefinitionProjectID),
+			DefinitionId: converter.Int(7),
+		}).
+		Return(&release.ReleaseDefinition{
+			Id:                converter.Int(7),
+			Name:              converter.String("Release"),
+			Path:              converter.String(`\folder`),
+			Revision:          converter.Int(2),
+			ReleaseNameFormat: converter.String("Release-$(rev:r)"),
+			Environments: &[]release.ReleaseDefinitionEnvironment{
+				{Id: converter.Int(1), Name: converter.String("dev"), Rank: converter.Int(1)},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataReleaseDefinition().Schema, map[string]interface{}{
+		"project_id": testReleaseDefinitionProjectID,
+		"name":       "release",
+		"path":       `\folder`,
+	})
+	diags := dataSourceReleaseDefinitionRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "7", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("revision"))
+	require.Equal(t, "dev", resourceData.Get("stage.0.name"))
+}
+
+func TestDataSourceReleaseDefinition_Read_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetReleaseDefinitions(clients.Ctx, gomock.Any()).
+		Return(&release.GetReleaseDefinitionsResponseValue{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataReleaseDefinition().Schema, map[string]interface{}{
+		"project_id": testReleaseDefinitionProjectID,
+		"name":       "release",
+	})
+	diags := dataSourceReleaseDefinitionRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "does not exist")
+}
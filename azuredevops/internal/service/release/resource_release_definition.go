@@ -0,0 +1,1599 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+)
+
+const (
+	rdArtifactTypeBuild     = "Build"
+	rdArtifactTypeGit       = "Git"
+	rdArtifactTypeContainer = "AzureContainerRepository"
+
+	rdConditionReleaseStarted = "ReleaseStarted"
+	// the environment state value of a stage which deployed successfully
+	rdEnvironmentStateSucceeded = "4"
+
+	rdTaskDefinitionTypeTask      = "task"
+	rdTaskDefinitionTypeTaskGroup = "metaTask"
+)
+
+// ResourceReleaseDefinition schema and implementation for release definition resource
+func ResourceReleaseDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReleaseDefinitionCreate,
+		ReadContext:   resourceReleaseDefinitionRead,
+		UpdateContext: resourceReleaseDefinitionUpdate,
+		DeleteContext: resourceReleaseDefinitionDelete,
+		Importer:      tfhelper.ImportProjectQualifiedResource(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      `\`,
+				ValidateFunc: validate.Path,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"release_name_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Release-$(rev:r)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"variable_groups": genVariableGroupsSchema(),
+			"variable":        genVariableSchema(),
+			"artifact": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"is_primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"build": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"project_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+									"definition_id": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"default_version_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "latestType",
+										ValidateFunc: validation.StringInSlice([]string{
+											"latestType",
+											"latestFromBranchType",
+											"latestWithBranchAndTagsType",
+											"selectDuringReleaseCreationType",
+										}, false),
+									},
+									"default_version_branch": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"default_version_tags": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotWhiteSpace,
+										},
+									},
+								},
+							},
+						},
+						"git": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"project_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+									"repository_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+									"branch": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+								},
+							},
+						},
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_connection_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+									"resource_group": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"registry_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"repository": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"continuous_deployment_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"artifact_alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"branch_filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+					},
+				},
+			},
+			"scheduled_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     genScheduleSchema(),
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"owner_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"manual_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"after_stages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						"pre_deploy_approval":  genApprovalSchema(),
+						"post_deploy_approval": genApprovalSchema(),
+						"pre_deploy_gate":      genGateSchema(),
+						"post_deploy_gate":     genGateSchema(),
+						"job": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"agent_pool_queue_id": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"agent_specification": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"condition": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "succeeded()",
+									},
+									"timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"cancel_timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(1, 60),
+									},
+									"skip_artifacts_download": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"demands": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotWhiteSpace,
+										},
+									},
+									"task": genTaskSchema(),
+								},
+							},
+						},
+						"variable_groups": genVariableGroupsSchema(),
+						"variable":        genVariableSchema(),
+						"retention": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_to_keep": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      30,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"releases_to_keep": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"retain_build": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func genVariableGroupsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func genVariableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+				"secret_value": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					Default:   "",
+				},
+				"is_secret": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"allow_override": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func genScheduleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"days_to_release": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(release.ScheduleDaysValues.Monday),
+						string(release.ScheduleDaysValues.Tuesday),
+						string(release.ScheduleDaysValues.Wednesday),
+						string(release.ScheduleDaysValues.Thursday),
+						string(release.ScheduleDaysValues.Friday),
+						string(release.ScheduleDaysValues.Saturday),
+						string(release.ScheduleDaysValues.Sunday),
+					}, false),
+				},
+			},
+			"start_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"start_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"schedule_only_with_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func genApprovalSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"approvers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+				"sequential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"required_approver_count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      43200,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"release_creator_can_be_approver": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"skip_if_approved_in_previous_stage": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"enforce_identity_revalidation": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"execution_order": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(release.ApprovalExecutionOrderValues.BeforeGates),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(release.ApprovalExecutionOrderValues.BeforeGates),
+						string(release.ApprovalExecutionOrderValues.AfterSuccessfulGates),
+						string(release.ApprovalExecutionOrderValues.AfterGatesAlways),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func genGateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1440,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"sampling_interval_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      15,
+					ValidateFunc: validation.IntAtLeast(5),
+				},
+				"stabilization_time_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      5,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"minimum_success_duration_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"task": func() *schema.Schema {
+					s := genTaskSchema()
+					s.Required = true
+					s.Optional = false
+					s.MinItems = 1
+					return s
+				}(),
+			},
+		},
+	}
+}
+
+func genTaskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"task_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"definition_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  rdTaskDefinitionTypeTask,
+					ValidateFunc: validation.StringInSlice([]string{
+						rdTaskDefinitionTypeTask,
+						rdTaskDefinitionTypeTaskGroup,
+					}, false),
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"ref_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"continue_on_error": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"always_run": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"condition": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "succeeded()",
+				},
+				"timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_count_on_task_failure": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"inputs": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceReleaseDefinitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	releaseDefinition, projectID, err := expandReleaseDefinition(d, nil)
+	if err != nil {
+		return diag.Errorf(" creating release definition: %+v", err)
+	}
+
+	createdDefinition, err := clients.ReleaseClient.CreateReleaseDefinition(clients.Ctx, release.CreateReleaseDefinitionArgs{
+		ReleaseDefinition: releaseDefinition,
+		Project:           converter.String(projectID),
+	})
+	if err != nil {
+		return diag.Errorf(" creating release definition: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*createdDefinition.Id))
+	return resourceReleaseDefinitionRead(ctx, d, m)
+}
+
+func resourceReleaseDefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID, definitionID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	releaseDefinition, err := clients.ReleaseClient.GetReleaseDefinition(clients.Ctx, release.GetReleaseDefinitionArgs{
+		Project:      converter.String(projectID),
+		DefinitionId: converter.Int(definitionID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" reading release definition %d: %+v", definitionID, err)
+	}
+
+	if releaseDefinition.IsDeleted != nil && *releaseDefinition.IsDeleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err := flattenReleaseDefinition(d, releaseDefinition, projectID); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceReleaseDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	// existing stages are identified by name, so that reordering stages does not recreate them
+	stageIDs := map[string]int{}
+	oldStages, _ := d.GetChange("stage")
+	for _, raw := range oldStages.([]interface{}) {
+		stage := raw.(map[string]interface{})
+		if id := stage["id"].(int); id != 0 {
+			stageIDs[stage["name"].(string)] = id
+		}
+	}
+
+	releaseDefinition, projectID, err := expandReleaseDefinition(d, stageIDs)
+	if err != nil {
+		return diag.Errorf(" updating release definition: %+v", err)
+	}
+
+	_, err = clients.ReleaseClient.UpdateReleaseDefinition(clients.Ctx, release.UpdateReleaseDefinitionArgs{
+		ReleaseDefinition: releaseDefinition,
+		Project:           converter.String(projectID),
+	})
+	if err != nil {
+		return diag.Errorf(" updating release definition %s: %+v", d.Id(), err)
+	}
+
+	return resourceReleaseDefinitionRead(ctx, d, m)
+}
+
+func resourceReleaseDefinitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID, definitionID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = clients.ReleaseClient.DeleteReleaseDefinition(clients.Ctx, release.DeleteReleaseDefinitionArgs{
+		Project:      converter.String(projectID),
+		DefinitionId: converter.Int(definitionID),
+	})
+	if err != nil {
+		return diag.Errorf(" deleting release definition %d: %+v", definitionID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandReleaseDefinition(d *schema.ResourceData, stageIDs map[string]int) (*release.ReleaseDefinition, string, error) {
+	projectID := d.Get("project_id").(string)
+
+	variables, err := expandVariables(d.Get("variable").(*schema.Set).List())
+	if err != nil {
+		return nil, "", err
+	}
+
+	artifacts, err := expandArtifacts(d.Get("artifact").([]interface{}))
+	if err != nil {
+		return nil, "", err
+	}
+
+	environments, err := expandEnvironments(d.Get("stage").([]interface{}), stageIDs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	releaseDefinition := &release.ReleaseDefinition{
+		Name:              converter.String(d.Get("name").(string)),
+		Path:              converter.String(d.Get("path").(string)),
+		Description:       converter.String(d.Get("description").(string)),
+		ReleaseNameFormat: converter.String(d.Get("release_name_format").(string)),
+		Variables:         variables,
+		VariableGroups:    expandVariableGroups(d.Get("variable_groups").(*schema.Set).List()),
+		Artifacts:         artifacts,
+		Environments:      environments,
+		Triggers:          expandTriggers(d),
+	}
+
+	if d.Id() != "" {
+		definitionID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, "", fmt.Errorf(" parsing release definition ID: %+v", err)
+		}
+		releaseDefinition.Id = converter.Int(definitionID)
+		releaseDefinition.Revision = converter.Int(d.Get("revision").(int))
+	}
+	return releaseDefinition, projectID, nil
+}
+
+func expandVariableGroups(variableGroups []interface{}) *[]int {
+	result := make([]int, 0, len(variableGroups))
+	for _, v := range variableGroups {
+		result = append(result, v.(int))
+	}
+	sort.Ints(result)
+	return &result
+}
+
+func expandVariables(variables []interface{}) (*map[string]release.ConfigurationVariableValue, error) {
+	result := map[string]release.ConfigurationVariableValue{}
+	for _, raw := range variables {
+		variable := raw.(map[string]interface{})
+		name := variable["name"].(string)
+		if _, ok := result[name]; ok {
+			return nil, fmt.Errorf(" found duplicate variable with name %s", name)
+		}
+
+		isSecret := variable["is_secret"].(bool)
+		value := variable["value"].(string)
+		if isSecret {
+			value = variable["secret_value"].(string)
+		}
+		result[name] = release.ConfigurationVariableValue{
+			Value:         converter.String(value),
+			IsSecret:      converter.Bool(isSecret),
+			AllowOverride: converter.Bool(variable["allow_override"].(bool)),
+		}
+	}
+	return &result, nil
+}
+
+func expandArtifacts(artifacts []interface{}) (*[]release.Artifact, error) {
+	result := make([]release.Artifact, 0, len(artifacts))
+	hasPrimary := false
+	for _, raw := range artifacts {
+		artifact := raw.(map[string]interface{})
+		alias := artifact["alias"].(string)
+
+		expanded := release.Artifact{
+			Alias:     converter.String(alias),
+			IsPrimary: converter.Bool(artifact["is_primary"].(bool)),
+		}
+		hasPrimary = hasPrimary || *expanded.IsPrimary
+
+		sources := 0
+		if v := artifact["build"].([]interface{}); len(v) > 0 && v[0] != nil {
+			sources++
+			buildArtifact := v[0].(map[string]interface{})
+			projectID := buildArtifact["project_id"].(string)
+			definitionID := strconv.Itoa(buildArtifact["definition_id"].(int))
+			reference := map[string]release.ArtifactSourceReference{
+				"project":            {Id: converter.String(projectID)},
+				"definition":         {Id: converter.String(definitionID)},
+				"defaultVersionType": {Id: converter.String(buildArtifact["default_version_type"].(string))},
+			}
+			if branch := buildArtifact["default_version_branch"].(string); branch != "" {
+				reference["defaultVersionBranch"] = release.ArtifactSourceReference{Id: converter.String(branch)}
+			}
+			if tags := tfhelper.ExpandStringList(buildArtifact["default_version_tags"].([]interface{})); len(tags) > 0 {
+				reference["defaultVersionTags"] = release.ArtifactSourceReference{Id: converter.String(strings.Join(tags, ","))}
+			}
+			expanded.Type = converter.String(rdArtifactTypeBuild)
+			expanded.SourceId = converter.String(projectID + ":" + definitionID)
+			expanded.DefinitionReference = &reference
+		}
+		if v := artifact["git"].([]interface{}); len(v) > 0 && v[0] != nil {
+			sources++
+			gitArtifact := v[0].(map[string]interface{})
+			projectID := gitArtifact["project_id"].(string)
+			repositoryID := gitArtifact["repository_id"].(string)
+			expanded.Type = converter.String(rdArtifactTypeGit)
+			expanded.SourceId = converter.String(projectID + ":" + repositoryID)
+			expanded.DefinitionReference = &map[string]release.ArtifactSourceReference{
+				"project":            {Id: converter.String(projectID)},
+				"definition":         {Id: converter.String(repositoryID)},
+				"branches":           {Id: converter.String(gitArtifact["branch"].(string))},
+				"defaultVersionType": {Id: converter.String("latestFromBranchType")},
+			}
+		}
+		if v := artifact["container"].([]interface{}); len(v) > 0 && v[0] != nil {
+			sources++
+			containerArtifact := v[0].(map[string]interface{})
+			expanded.Type = converter.String(rdArtifactTypeContainer)
+			expanded.DefinitionReference = &map[string]release.ArtifactSourceReference{
+				"connection":         {Id: converter.String(containerArtifact["service_connection_id"].(string))},
+				"resourceGroup":      {Id: converter.String(containerArtifact["resource_group"].(string))},
+				"registryurl":        {Id: converter.String(containerArtifact["registry_url"].(string))},
+				"repository":         {Id: converter.String(containerArtifact["repository"].(string))},
+				"defaultVersionType": {Id: converter.String("latestType")},
+			}
+		}
+		if sources != 1 {
+			return nil, fmt.Errorf(" artifact %s must specify exactly one of build, git or container", alias)
+		}
+		result = append(result, expanded)
+	}
+
+	// a release definition with artifacts requires a primary artifact
+	if !hasPrimary && len(result) > 0 {
+		result[0].IsPrimary = converter.Bool(true)
+	}
+	return &result, nil
+}
+
+func expandTriggers(d *schema.ResourceData) *[]interface{} {
+	triggers := []interface{}{}
+	for _, raw := range d.Get("continuous_deployment_trigger").([]interface{}) {
+		trigger := raw.(map[string]interface{})
+		var conditions []release.ArtifactFilter
+		for _, branch := range tfhelper.ExpandStringList(trigger["branch_filters"].([]interface{})) {
+			conditions = append(conditions, release.ArtifactFilter{
+				SourceBranch: converter.String(branch),
+			})
+		}
+		triggers = append(triggers, release.ArtifactSourceTrigger{
+			TriggerType:       &release.ReleaseTriggerTypeValues.ArtifactSource,
+			ArtifactAlias:     converter.String(trigger["artifact_alias"].(string)),
+			TriggerConditions: &conditions,
+		})
+	}
+	for _, raw := range d.Get("scheduled_trigger").([]interface{}) {
+		triggers = append(triggers, release.ScheduledReleaseTrigger{
+			TriggerType: &release.ReleaseTriggerTypeValues.Schedule,
+			Schedule:    expandSchedule(raw.(map[string]interface{})),
+		})
+	}
+	return &triggers
+}
+
+func expandSchedule(schedule map[string]interface{}) *release.ReleaseSchedule {
+	days := tfhelper.ExpandStringSet(schedule["days_to_release"].(*schema.Set))
+	sort.Strings(days)
+	daysToRelease := release.ScheduleDays(strings.Join(days, ", "))
+	return &release.ReleaseSchedule{
+		DaysToRelease:           &daysToRelease,
+		StartHours:              converter.Int(schedule["start_hours"].(int)),
+		StartMinutes:            converter.Int(schedule["start_minutes"].(int)),
+		TimeZoneId:              converter.String(schedule["time_zone"].(string)),
+		ScheduleOnlyWithChanges: converter.Bool(schedule["schedule_only_with_changes"].(bool)),
+	}
+}
+
+func expandEnvironments(stages []interface{}, stageIDs map[string]int) (*[]release.ReleaseDefinitionEnvironment, error) {
+	environments := make([]release.ReleaseDefinitionEnvironment, 0, len(stages))
+	stageNames := map[string]bool{}
+	for _, raw := range stages {
+		stageNames[raw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for i, raw := range stages {
+		stage := raw.(map[string]interface{})
+		name := stage["name"].(string)
+
+		ownerID, err := uuid.Parse(stage["owner_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf(" parsing owner ID of stage %s: %+v", name, err)
+		}
+
+		conditions := []release.Condition{}
+		afterStages := tfhelper.ExpandStringList(stage["after_stages"].([]interface{}))
+		if stage["manual_only"].(bool) {
+			if len(afterStages) > 0 {
+				return nil, fmt.Errorf(" stage %s can not be manual only and be triggered after other stages", name)
+			}
+		} else if len(afterStages) == 0 {
+			conditions = append(conditions, release.Condition{
+				Name:          converter.String(rdConditionReleaseStarted),
+				ConditionType: &release.ConditionTypeValues.Event,
+				Value:         converter.String(""),
+			})
+		} else {
+			for _, afterStage := range afterStages {
+				if !stageNames[afterStage] {
+					return nil, fmt.Errorf(" stage %s is triggered after unknown stage %s", name, afterStage)
+				}
+				conditions = append(conditions, release.Condition{
+					Name:          converter.String(afterStage),
+					ConditionType: &release.ConditionTypeValues.EnvironmentState,
+					Value:         converter.String(rdEnvironmentStateSucceeded),
+				})
+			}
+		}
+
+		variables, err := expandVariables(stage["variable"].(*schema.Set).List())
+		if err != nil {
+			return nil, err
+		}
+
+		deployPhases := []interface{}{}
+		for j, job := range stage["job"].([]interface{}) {
+			deployPhase, err := expandDeployPhase(job.(map[string]interface{}), j+1)
+			if err != nil {
+				return nil, err
+			}
+			deployPhases = append(deployPhases, deployPhase)
+		}
+
+		preDeployGates, err := expandGates(stage["pre_deploy_gate"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		postDeployGates, err := expandGates(stage["post_deploy_gate"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		environment := release.ReleaseDefinitionEnvironment{
+			Name:                converter.String(name),
+			Rank:                converter.Int(i + 1),
+			Owner:               &webapi.IdentityRef{Id: converter.String(ownerID.String())},
+			Conditions:          &conditions,
+			Variables:           variables,
+			VariableGroups:      expandVariableGroups(stage["variable_groups"].(*schema.Set).List()),
+			PreDeployApprovals:  expandApprovals(stage["pre_deploy_approval"].([]interface{})),
+			PostDeployApprovals: expandApprovals(stage["post_deploy_approval"].([]interface{})),
+			PreDeploymentGates:  preDeployGates,
+			PostDeploymentGates: postDeployGates,
+			DeployPhases:        &deployPhases,
+			RetentionPolicy:     expandEnvironmentRetentionPolicy(stage["retention"].([]interface{})),
+			ExecutionPolicy: &release.EnvironmentExecutionPolicy{
+				ConcurrencyCount: converter.Int(1),
+				QueueDepthCount:  converter.Int(0),
+			},
+			EnvironmentOptions: &release.EnvironmentOptions{
+				EmailNotificationType:   converter.String("OnlyOnFailure"),
+				EmailRecipients:         converter.String("release.environment.owner;release.creator"),
+				PublishDeploymentStatus: converter.Bool(true),
+				TimeoutInMinutes:        converter.Int(0),
+			},
+		}
+		if id, ok := stageIDs[name]; ok {
+			environment.Id = converter.Int(id)
+		}
+		environments = append(environments, environment)
+	}
+	return &environments, nil
+}
+
+func expandApprovals(approvals []interface{}) *release.ReleaseDefinitionApprovals {
+	// without approvers the deployment is approved automatically
+	if len(approvals) == 0 || approvals[0] == nil {
+		return &release.ReleaseDefinitionApprovals{
+			Approvals: &[]release.ReleaseDefinitionApprovalStep{{
+				IsAutomated:      converter.Bool(true),
+				IsNotificationOn: converter.Bool(false),
+				Rank:             converter.Int(1),
+			}},
+			ApprovalOptions: &release.ApprovalOptions{
+				ExecutionOrder: &release.ApprovalExecutionOrderValues.BeforeGates,
+			},
+		}
+	}
+
+	approval := approvals[0].(map[string]interface{})
+	sequential := approval["sequential"].(bool)
+	steps := []release.ReleaseDefinitionApprovalStep{}
+	for i, approver := range tfhelper.ExpandStringList(approval["approvers"].([]interface{})) {
+		rank := 1
+		if sequential {
+			rank = i + 1
+		}
+		steps = append(steps, release.ReleaseDefinitionApprovalStep{
+			Approver:         &webapi.IdentityRef{Id: converter.String(approver)},
+			IsAutomated:      converter.Bool(false),
+			IsNotificationOn: converter.Bool(false),
+			Rank:             converter.Int(rank),
+		})
+	}
+	executionOrder := release.ApprovalExecutionOrder(approval["execution_order"].(string))
+	return &release.ReleaseDefinitionApprovals{
+		Approvals: &steps,
+		ApprovalOptions: &release.ApprovalOptions{
+			RequiredApproverCount:       converter.Int(approval["required_approver_count"].(int)),
+			TimeoutInMinutes:            converter.Int(approval["timeout_in_minutes"].(int)),
+			ReleaseCreatorCanBeApprover: converter.Bool(approval["release_creator_can_be_approver"].(bool)),
+			AutoTriggeredAndPreviousEnvironmentApprovedCanBeSkipped: converter.Bool(approval["skip_if_approved_in_previous_stage"].(bool)),
+			EnforceIdentityRevalidation:                             converter.Bool(approval["enforce_identity_revalidation"].(bool)),
+			ExecutionOrder:                                          &executionOrder,
+		},
+	}
+}
+
+func expandGates(gates []interface{}) (*release.ReleaseDefinitionGatesStep, error) {
+	if len(gates) == 0 || gates[0] == nil {
+		return &release.ReleaseDefinitionGatesStep{
+			Gates: &[]release.ReleaseDefinitionGate{},
+			GatesOptions: &release.ReleaseDefinitionGatesOptions{
+				IsEnabled: converter.Bool(false),
+			},
+		}, nil
+	}
+
+	gate := gates[0].(map[string]interface{})
+	tasks, err := expandWorkflowTasks(gate["task"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &release.ReleaseDefinitionGatesStep{
+		Gates: &[]release.ReleaseDefinitionGate{{Tasks: tasks}},
+		GatesOptions: &release.ReleaseDefinitionGatesOptions{
+			IsEnabled:              converter.Bool(true),
+			Timeout:                converter.Int(gate["timeout_in_minutes"].(int)),
+			SamplingInterval:       converter.Int(gate["sampling_interval_in_minutes"].(int)),
+			StabilizationTime:      converter.Int(gate["stabilization_time_in_minutes"].(int)),
+			MinimumSuccessDuration: converter.Int(gate["minimum_success_duration_in_minutes"].(int)),
+		},
+	}, nil
+}
+
+func expandDeployPhase(job map[string]interface{}, rank int) (*release.AgentBasedDeployPhase, error) {
+	tasks, err := expandWorkflowTasks(job["task"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	demands := []interface{}{}
+	for _, demand := range tfhelper.ExpandStringList(job["demands"].([]interface{})) {
+		demands = append(demands, demand)
+	}
+
+	deploymentInput := &release.AgentDeploymentInput{
+		QueueId:                   converter.Int(job["agent_pool_queue_id"].(int)),
+		Condition:                 converter.String(job["condition"].(string)),
+		TimeoutInMinutes:          converter.Int(job["timeout_in_minutes"].(int)),
+		JobCancelTimeoutInMinutes: converter.Int(job["cancel_timeout_in_minutes"].(int)),
+		SkipArtifactsDownload:     converter.Bool(job["skip_artifacts_download"].(bool)),
+		Demands:                   &demands,
+		EnableAccessToken:         converter.Bool(false),
+		ParallelExecution: &release.ExecutionInput{
+			ParallelExecutionType: &release.ParallelExecutionTypesValues.None,
+		},
+		ArtifactsDownloadInput: &release.ArtifactsDownloadInput{
+			DownloadInputs: &[]release.ArtifactDownloadInputBase{},
+		},
+		OverrideInputs: &map[string]string{},
+	}
+	if spec := job["agent_specification"].(string); spec != "" {
+		deploymentInput.AgentSpecification = &release.AgentSpecification{Identifier: converter.String(spec)}
+	}
+
+	return &release.AgentBasedDeployPhase{
+		Name:            converter.String(job["name"].(string)),
+		Rank:            converter.Int(rank),
+		PhaseType:       &release.DeployPhaseTypesValues.AgentBasedDeployment,
+		WorkflowTasks:   tasks,
+		DeploymentInput: deploymentInput,
+	}, nil
+}
+
+func expandWorkflowTasks(tasks []interface{}) (*[]release.WorkflowTask, error) {
+	result := make([]release.WorkflowTask, 0, len(tasks))
+	for _, raw := range tasks {
+		task := raw.(map[string]interface{})
+		taskID, err := uuid.Parse(task["task_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf(" parsing task ID: %+v", err)
+		}
+		result = append(result, release.WorkflowTask{
+			TaskId:                  &taskID,
+			Version:                 converter.String(task["version"].(string)),
+			DefinitionType:          converter.String(task["definition_type"].(string)),
+			Name:                    converter.String(task["display_name"].(string)),
+			RefName:                 converter.String(task["ref_name"].(string)),
+			Enabled:                 converter.Bool(task["enabled"].(bool)),
+			ContinueOnError:         converter.Bool(task["continue_on_error"].(bool)),
+			AlwaysRun:               converter.Bool(task["always_run"].(bool)),
+			Condition:               converter.String(task["condition"].(string)),
+			TimeoutInMinutes:        converter.Int(task["timeout_in_minutes"].(int)),
+			RetryCountOnTaskFailure: converter.Int(task["retry_count_on_task_failure"].(int)),
+			Inputs:                  tfhelper.ExpandStringMap(task["inputs"].(map[string]interface{})),
+			Environment:             tfhelper.ExpandStringMap(task["environment"].(map[string]interface{})),
+		})
+	}
+	return &result, nil
+}
+
+func expandEnvironmentRetentionPolicy(retention []interface{}) *release.EnvironmentRetentionPolicy {
+	if len(retention) == 0 || retention[0] == nil {
+		return &release.EnvironmentRetentionPolicy{
+			DaysToKeep:     converter.Int(30),
+			ReleasesToKeep: converter.Int(3),
+			RetainBuild:    converter.Bool(true),
+		}
+	}
+	policy := retention[0].(map[string]interface{})
+	return &release.EnvironmentRetentionPolicy{
+		DaysToKeep:     converter.Int(policy["days_to_keep"].(int)),
+		ReleasesToKeep: converter.Int(policy["releases_to_keep"].(int)),
+		RetainBuild:    converter.Bool(policy["retain_build"].(bool)),
+	}
+}
+
+func flattenReleaseDefinition(d *schema.ResourceData, releaseDefinition *release.ReleaseDefinition, projectID string) error {
+	d.Set("project_id", projectID)
+	d.Set("name", converter.ToString(releaseDefinition.Name, ""))
+	d.Set("path", converter.ToString(releaseDefinition.Path, `\`))
+	d.Set("description", converter.ToString(releaseDefinition.Description, ""))
+	d.Set("release_name_format", converter.ToString(releaseDefinition.ReleaseNameFormat, ""))
+	if releaseDefinition.Revision != nil {
+		d.Set("revision", *releaseDefinition.Revision)
+	}
+	d.Set("variable_groups", flattenVariableGroups(releaseDefinition.VariableGroups))
+
+	var stateVariables []interface{}
+	if v, ok := d.GetOk("variable"); ok {
+		stateVariables = v.(*schema.Set).List()
+	}
+	if err := d.Set("variable", flattenVariables(releaseDefinition.Variables, stateVariables)); err != nil {
+		return fmt.Errorf(" setting variable: %+v", err)
+	}
+
+	if err := d.Set("artifact", flattenArtifacts(releaseDefinition.Artifacts)); err != nil {
+		return fmt.Errorf(" setting artifact: %+v", err)
+	}
+
+	cdTriggers, scheduledTriggers := flattenTriggers(releaseDefinition.Triggers)
+	if err := d.Set("continuous_deployment_trigger", cdTriggers); err != nil {
+		return fmt.Errorf(" setting continuous_deployment_trigger: %+v", err)
+	}
+	if err := d.Set("scheduled_trigger", scheduledTriggers); err != nil {
+		return fmt.Errorf(" setting scheduled_trigger: %+v", err)
+	}
+
+	stages, err := flattenEnvironments(d, releaseDefinition.Environments)
+	if err != nil {
+		return err
+	}
+	// the service adds the default values of all task inputs, only the configured inputs are kept
+	tfhelper.RemoveUnconfiguredMapKeys(stages, d.Get("stage"), "inputs")
+	if err := d.Set("stage", stages); err != nil {
+		return fmt.Errorf(" setting stage: %+v", err)
+	}
+	return nil
+}
+
+func flattenVariableGroups(variableGroups *[]int) []interface{} {
+	if variableGroups == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*variableGroups))
+	for _, id := range *variableGroups {
+		result = append(result, id)
+	}
+	return result
+}
+
+// flattenVariables flattens the variables, the values of secret variables are not returned by the service and are read from the state
+func flattenVariables(variables *map[string]release.ConfigurationVariableValue, stateVariables []interface{}) []interface{} {
+	if variables == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*variables))
+	for name, value := range *variables {
+		isSecret := converter.ToBool(value.IsSecret, false)
+		variable := map[string]interface{}{
+			"name":           name,
+			"value":          converter.ToString(value.Value, ""),
+			"secret_value":   "",
+			"is_secret":      isSecret,
+			"allow_override": converter.ToBool(value.AllowOverride, false),
+		}
+		if isSecret {
+			variable["value"] = ""
+			for _, raw := range stateVariables {
+				if stateVariable := raw.(map[string]interface{}); stateVariable["name"] == name {
+					variable["secret_value"] = stateVariable["secret_value"]
+				}
+			}
+		}
+		result = append(result, variable)
+	}
+	return result
+}
+
+func flattenArtifacts(artifacts *[]release.Artifact) []interface{} {
+	if artifacts == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*artifacts))
+	for _, artifact := range *artifacts {
+		reference := map[string]release.ArtifactSourceReference{}
+		if artifact.DefinitionReference != nil {
+			reference = *artifact.DefinitionReference
+		}
+		referenceID := func(key string) string {
+			return converter.ToString(reference[key].Id, "")
+		}
+
+		flattened := map[string]interface{}{
+			"alias":      converter.ToString(artifact.Alias, ""),
+			"is_primary": converter.ToBool(artifact.IsPrimary, false),
+		}
+		switch converter.ToString(artifact.Type, "") {
+		case rdArtifactTypeBuild:
+			definitionID, _ := strconv.Atoi(referenceID("definition"))
+			var tags []string
+			if v := referenceID("defaultVersionTags"); v != "" {
+				tags = strings.Split(v, ",")
+			}
+			flattened["build"] = []interface{}{map[string]interface{}{
+				"project_id":             referenceID("project"),
+				"definition_id":          definitionID,
+				"default_version_type":   referenceID("defaultVersionType"),
+				"default_version_branch": referenceID("defaultVersionBranch"),
+				"default_version_tags":   tags,
+			}}
+		case rdArtifactTypeGit:
+			flattened["git"] = []interface{}{map[string]interface{}{
+				"project_id":    referenceID("project"),
+				"repository_id": referenceID("definition"),
+				"branch":        referenceID("branches"),
+			}}
+		case rdArtifactTypeContainer:
+			flattened["container"] = []interface{}{map[string]interface{}{
+				"service_connection_id": referenceID("connection"),
+				"resource_group":        referenceID("resourceGroup"),
+				"registry_url":          referenceID("registryurl"),
+				"repository":            referenceID("repository"),
+			}}
+		}
+		result = append(result, flattened)
+	}
+	return result
+}
+
+// flattenTriggers converts the untyped triggers of the service into continuous deployment and scheduled triggers
+func flattenTriggers(triggers *[]interface{}) ([]interface{}, []interface{}) {
+	cdTriggers := []interface{}{}
+	scheduledTriggers := []interface{}{}
+	if triggers == nil {
+		return cdTriggers, scheduledTriggers
+	}
+
+	for _, raw := range *triggers {
+		trigger, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch trigger["triggerType"] {
+		case string(release.ReleaseTriggerTypeValues.ArtifactSource):
+			var artifactTrigger release.ArtifactSourceTrigger
+			if err := remarshal(trigger, &artifactTrigger); err != nil {
+				continue
+			}
+			branches := []string{}
+			if artifactTrigger.TriggerConditions != nil {
+				for _, condition := range *artifactTrigger.TriggerConditions {
+					if condition.SourceBranch != nil {
+						branches = append(branches, *condition.SourceBranch)
+					}
+				}
+			}
+			cdTriggers = append(cdTriggers, map[string]interface{}{
+				"artifact_alias": converter.ToString(artifactTrigger.ArtifactAlias, ""),
+				"branch_filters": branches,
+			})
+		case string(release.ReleaseTriggerTypeValues.Schedule):
+			var scheduledTrigger release.ScheduledReleaseTrigger
+			if err := remarshal(trigger, &scheduledTrigger); err != nil || scheduledTrigger.Schedule == nil {
+				continue
+			}
+			scheduledTriggers = append(scheduledTriggers, flattenSchedule(scheduledTrigger.Schedule))
+		}
+	}
+	return cdTriggers, scheduledTriggers
+}
+
+func flattenSchedule(schedule *release.ReleaseSchedule) map[string]interface{} {
+	days := []interface{}{}
+	if schedule.DaysToRelease != nil {
+		for _, day := range strings.Split(string(*schedule.DaysToRelease), ",") {
+			day = strings.TrimSpace(day)
+			if day == string(release.ScheduleDaysValues.All) {
+				return flattenScheduleWithDays(schedule, []interface{}{
+					string(release.ScheduleDaysValues.Monday),
+					string(release.ScheduleDaysValues.Tuesday),
+					string(release.ScheduleDaysValues.Wednesday),
+					string(release.ScheduleDaysValues.Thursday),
+					string(release.ScheduleDaysValues.Friday),
+					string(release.ScheduleDaysValues.Saturday),
+					string(release.ScheduleDaysValues.Sunday),
+				})
+			}
+			if day != "" && day != string(release.ScheduleDaysValues.None) {
+				days = append(days, day)
+			}
+		}
+	}
+	return flattenScheduleWithDays(schedule, days)
+}
+
+func flattenScheduleWithDays(schedule *release.ReleaseSchedule, days []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"days_to_release":            schema.NewSet(schema.HashString, days),
+		"start_hours":                converter.ToInt(schedule.StartHours, 0),
+		"start_minutes":              converter.ToInt(schedule.StartMinutes, 0),
+		"time_zone":                  converter.ToString(schedule.TimeZoneId, ""),
+		"schedule_only_with_changes": converter.ToBool(schedule.ScheduleOnlyWithChanges, false),
+	}
+}
+
+func flattenEnvironments(d *schema.ResourceData, environments *[]release.ReleaseDefinitionEnvironment) ([]interface{}, error) {
+	if environments == nil {
+		return nil, nil
+	}
+
+	sorted := append([]release.ReleaseDefinitionEnvironment{}, *environments...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return converter.ToInt(sorted[i].Rank, 0) < converter.ToInt(sorted[j].Rank, 0)
+	})
+
+	// the secret values of stage variables are read from the state of the stage with the same name
+	stateVariables := map[string][]interface{}{}
+	if v, ok := d.GetOk("stage"); ok {
+		for _, raw := range v.([]interface{}) {
+			if stage, ok := raw.(map[string]interface{}); ok {
+				if variables, ok := stage["variable"].(*schema.Set); ok {
+					stateVariables[stage["name"].(string)] = variables.List()
+				}
+			}
+		}
+	}
+
+	result := make([]interface{}, 0, len(sorted))
+	for _, environment := range sorted {
+		name := converter.ToString(environment.Name, "")
+
+		manualOnly := true
+		afterStages := []string{}
+		if environment.Conditions != nil {
+			for _, condition := range *environment.Conditions {
+				if condition.ConditionType == nil {
+					continue
+				}
+				switch *condition.ConditionType {
+				case release.ConditionTypeValues.Event:
+					manualOnly = false
+				case release.ConditionTypeValues.EnvironmentState:
+					manualOnly = false
+					afterStages = append(afterStages, converter.ToString(condition.Name, ""))
+				}
+			}
+		}
+
+		jobs, err := flattenDeployPhases(environment.DeployPhases)
+		if err != nil {
+			return nil, fmt.Errorf(" flattening jobs of stage %s: %+v", name, err)
+		}
+
+		stage := map[string]interface{}{
+			"id":                   converter.ToInt(environment.Id, 0),
+			"name":                 name,
+			"manual_only":          manualOnly,
+			"after_stages":         afterStages,
+			"pre_deploy_approval":  flattenApprovals(environment.PreDeployApprovals),
+			"post_deploy_approval": flattenApprovals(environment.PostDeployApprovals),
+			"pre_deploy_gate":      flattenGates(environment.PreDeploymentGates),
+			"post_deploy_gate":     flattenGates(environment.PostDeploymentGates),
+			"job":                  jobs,
+			"variable_groups":      schema.NewSet(schema.HashInt, flattenVariableGroups(environment.VariableGroups)),
+			"variable":             flattenVariables(environment.Variables, stateVariables[name]),
+			"retention":            flattenEnvironmentRetentionPolicy(environment.RetentionPolicy),
+		}
+		if environment.Owner != nil {
+			stage["owner_id"] = converter.ToString(environment.Owner.Id, "")
+		}
+		result = append(result, stage)
+	}
+	return result, nil
+}
+
+func flattenApprovals(approvals *release.ReleaseDefinitionApprovals) []interface{} {
+	if approvals == nil || approvals.Approvals == nil {
+		return nil
+	}
+
+	approvers := []string{}
+	sequential := false
+	for _, step := range *approvals.Approvals {
+		if converter.ToBool(step.IsAutomated, false) || step.Approver == nil {
+			continue
+		}
+		approvers = append(approvers, converter.ToString(step.Approver.Id, ""))
+		if converter.ToInt(step.Rank, 1) > 1 {
+			sequential = true
+		}
+	}
+	if len(approvers) == 0 {
+		return nil
+	}
+
+	approval := map[string]interface{}{
+		"approvers":  approvers,
+		"sequential": sequential,
+	}
+	if options := approvals.ApprovalOptions; options != nil {
+		approval["required_approver_count"] = converter.ToInt(options.RequiredApproverCount, 0)
+		approval["timeout_in_minutes"] = converter.ToInt(options.TimeoutInMinutes, 0)
+		approval["release_creator_can_be_approver"] = converter.ToBool(options.ReleaseCreatorCanBeApprover, false)
+		approval["skip_if_approved_in_previous_stage"] = converter.ToBool(options.AutoTriggeredAndPreviousEnvironmentApprovedCanBeSkipped, false)
+		approval["enforce_identity_revalidation"] = converter.ToBool(options.EnforceIdentityRevalidation, false)
+		if options.ExecutionOrder != nil {
+			approval["execution_order"] = string(*options.ExecutionOrder)
+		}
+	}
+	return []interface{}{approval}
+}
+
+func flattenGates(gates *release.ReleaseDefinitionGatesStep) []interface{} {
+	if gates == nil || gates.GatesOptions == nil || !converter.ToBool(gates.GatesOptions.IsEnabled, false) {
+		return nil
+	}
+
+	tasks := []interface{}{}
+	if gates.Gates != nil {
+		for _, gate := range *gates.Gates {
+			tasks = append(tasks, flattenWorkflowTasks(gate.Tasks)...)
+		}
+	}
+	options := gates.GatesOptions
+	return []interface{}{map[string]interface{}{
+		"timeout_in_minutes":                  converter.ToInt(options.Timeout, 0),
+		"sampling_interval_in_minutes":        converter.ToInt(options.SamplingInterval, 0),
+		"stabilization_time_in_minutes":       converter.ToInt(options.StabilizationTime, 0),
+		"minimum_success_duration_in_minutes": converter.ToInt(options.MinimumSuccessDuration, 0),
+		"task":                                tasks,
+	}}
+}
+
+func flattenDeployPhases(deployPhases *[]interface{}) ([]interface{}, error) {
+	if deployPhases == nil {
+		return nil, nil
+	}
+
+	phases := make([]release.AgentBasedDeployPhase, 0, len(*deployPhases))
+	for _, raw := range *deployPhases {
+		var phase release.AgentBasedDeployPhase
+		if err := remarshal(raw, &phase); err != nil {
+			return nil, err
+		}
+		phases = append(phases, phase)
+	}
+	sort.SliceStable(phases, func(i, j int) bool {
+		return converter.ToInt(phases[i].Rank, 0) < converter.ToInt(phases[j].Rank, 0)
+	})
+
+	result := make([]interface{}, 0, len(phases))
+	for _, phase := range phases {
+		job := map[string]interface{}{
+			"name": converter.ToString(phase.Name, ""),
+			"task": flattenWorkflowTasks(phase.WorkflowTasks),
+		}
+		if input := phase.DeploymentInput; input != nil {
+			job["agent_pool_queue_id"] = converter.ToInt(input.QueueId, 0)
+			job["condition"] = converter.ToString(input.Condition, "")
+			job["timeout_in_minutes"] = converter.ToInt(input.TimeoutInMinutes, 0)
+			job["cancel_timeout_in_minutes"] = converter.ToInt(input.JobCancelTimeoutInMinutes, 0)
+			job["skip_artifacts_download"] = converter.ToBool(input.SkipArtifactsDownload, false)
+			if input.AgentSpecification != nil {
+				job["agent_specification"] = converter.ToString(input.AgentSpecification.Identifier, "")
+			}
+			demands := []string{}
+			if input.Demands != nil {
+				for _, demand := range *input.Demands {
+					if v, ok := demand.(string); ok {
+						demands = append(demands, v)
+					}
+				}
+			}
+			job["demands"] = demands
+		}
+		result = append(result, job)
+	}
+	return result, nil
+}
+
+func flattenWorkflowTasks(tasks *[]release.WorkflowTask) []interface{} {
+	if tasks == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*tasks))
+	for _, task := range *tasks {
+		flattened := map[string]interface{}{
+			"version":                     converter.ToString(task.Version, ""),
+			"definition_type":             converter.ToString(task.DefinitionType, rdTaskDefinitionTypeTask),
+			"display_name":                converter.ToString(task.Name, ""),
+			"ref_name":                    converter.ToString(task.RefName, ""),
+			"enabled":                     converter.ToBool(task.Enabled, true),
+			"continue_on_error":           converter.ToBool(task.ContinueOnError, false),
+			"always_run":                  converter.ToBool(task.AlwaysRun, false),
+			"condition":                   converter.ToString(task.Condition, ""),
+			"timeout_in_minutes":          converter.ToInt(task.TimeoutInMinutes, 0),
+			"retry_count_on_task_failure": converter.ToInt(task.RetryCountOnTaskFailure, 0),
+		}
+		if task.TaskId != nil {
+			flattened["task_id"] = task.TaskId.String()
+		}
+		if task.Inputs != nil {
+			flattened["inputs"] = *task.Inputs
+		}
+		if task.Environment != nil {
+			flattened["environment"] = *task.Environment
+		}
+		result = append(result, flattened)
+	}
+	return result
+}
+
+func flattenEnvironmentRetentionPolicy(policy *release.EnvironmentRetentionPolicy) []interface{} {
+	if policy == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"days_to_keep":     converter.ToInt(policy.DaysToKeep, 0),
+		"releases_to_keep": converter.ToInt(policy.ReleasesToKeep, 0),
+		"retain_build":     converter.ToBool(policy.RetainBuild, false),
+	}}
+}
+
+// remarshal converts the untyped JSON objects returned by the service into the typed models of the SDK
+func remarshal(in interface{}, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,245 @@
+//go:build (all || resource_release_definition) && !exclude_resource_release_definition
+// +build all resource_release_definition
+// +build !exclude_resource_release_definition
+
+package release
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testReleaseDefinitionProjectID = "00000000-0000-0000-0000-000000000001"
+	testReleaseDefinitionOwnerID   = "00000000-0000-0000-0000-000000000002"
+	testReleaseDefinitionTaskID    = "00000000-0000-0000-0000-000000000003"
+)
+
+func getReleaseDefinitionTask() map[string]interface{} {
+	return map[string]interface{}{
+		"task_id":      testReleaseDefinitionTaskID,
+		"version":      "2.*",
+		"display_name": "Run script",
+		"inputs":       map[string]interface{}{"script": "echo hello"},
+	}
+}
+
+func getReleaseDefinitionConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"project_id": testReleaseDefinitionProjectID,
+		"name":       "release",
+		"path":       `\folder`,
+		"variable": []interface{}{
+			map[string]interface{}{"name": "plain", "value": "value"},
+			map[string]interface{}{"name": "secret", "secret_value": "s3cr3t", "is_secret": true},
+		},
+		"variable_groups": []interface{}{2, 1},
+		"artifact": []interface{}{
+			map[string]interface{}{
+				"alias": "_build",
+				"build": []interface{}{map[string]interface{}{
+					"project_id":             testReleaseDefinitionProjectID,
+					"definition_id":          10,
+					"default_version_type":   "latestFromBranchType",
+					"default_version_branch": "refs/heads/main",
+				}},
+			},
+			map[string]interface{}{
+				"alias": "_repo",
+				"git": []interface{}{map[string]interface{}{
+					"project_id":    testReleaseDefinitionProjectID,
+					"repository_id": "00000000-0000-0000-0000-000000000004",
+					"branch":        "main",
+				}},
+			},
+		},
+		"continuous_deployment_trigger": []interface{}{
+			map[string]interface{}{"artifact_alias": "_build", "branch_filters": []interface{}{"main"}},
+		},
+		"scheduled_trigger": []interface{}{
+			map[string]interface{}{"days_to_release": []interface{}{"monday", "friday"}, "start_hours": 3},
+		},
+		"stage": []interface{}{
+			map[string]interface{}{
+				"name":     "dev",
+				"owner_id": testReleaseDefinitionOwnerID,
+				"job": []interface{}{map[string]interface{}{
+					"name":                "Agent job",
+					"agent_pool_queue_id": 5,
+					"agent_specification": "ubuntu-latest",
+					"task":                []interface{}{getReleaseDefinitionTask()},
+				}},
+			},
+			map[string]interface{}{
+				"name":         "prod",
+				"owner_id":     testReleaseDefinitionOwnerID,
+				"after_stages": []interface{}{"dev"},
+				"pre_deploy_approval": []interface{}{map[string]interface{}{
+					"approvers":  []interface{}{testReleaseDefinitionOwnerID},
+					"sequential": false,
+				}},
+				"post_deploy_gate": []interface{}{map[string]interface{}{
+					"task": []interface{}{getReleaseDefinitionTask()},
+				}},
+				"job": []interface{}{map[string]interface{}{
+					"name":                "Agent job",
+					"agent_pool_queue_id": 5,
+				}},
+				"variable": []interface{}{
+					map[string]interface{}{"name": "stage_secret", "secret_value": "s3cr3t", "is_secret": true},
+				},
+				"retention": []interface{}{map[string]interface{}{"days_to_keep": 60, "releases_to_keep": 5}},
+			},
+		},
+	}
+}
+
+// toServiceModel converts the expanded definition into the untyped model returned by the service
+func toServiceModel(t *testing.T, definition *release.ReleaseDefinition) *release.ReleaseDefinition {
+	var result release.ReleaseDefinition
+	require.Nil(t, remarshal(definition, &result))
+	// the service does not return the values of secret variables
+	for name, variable := range *result.Variables {
+		if converter.ToBool(variable.IsSecret, false) {
+			variable.Value = nil
+			(*result.Variables)[name] = variable
+		}
+	}
+	return &result
+}
+
+func TestReleaseDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, getReleaseDefinitionConfig())
+	resourceData.SetId("7")
+
+	expanded, projectID, err := expandReleaseDefinition(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testReleaseDefinitionProjectID, projectID)
+	require.Equal(t, 7, *expanded.Id)
+	require.True(t, *(*expanded.Artifacts)[0].IsPrimary)
+	require.False(t, *(*expanded.Artifacts)[1].IsPrimary)
+
+	environments := *expanded.Environments
+	require.Equal(t, release.ConditionTypeValues.Event, *(*environments[0].Conditions)[0].ConditionType)
+	require.Equal(t, "dev", *(*environments[1].Conditions)[0].Name)
+	require.True(t, *(*environments[0].PreDeployApprovals.Approvals)[0].IsAutomated)
+	require.False(t, *(*environments[1].PreDeployApprovals.Approvals)[0].IsAutomated)
+	require.True(t, *environments[1].PostDeploymentGates.GatesOptions.IsEnabled)
+	require.Equal(t, 60, *environments[1].RetentionPolicy.DaysToKeep)
+	require.Equal(t, 30, *environments[0].RetentionPolicy.DaysToKeep)
+
+	require.Nil(t, flattenReleaseDefinition(resourceData, toServiceModel(t, expanded), projectID))
+	require.Equal(t, "s3cr3t", resourceData.Get("stage.1.variable").(*schema.Set).List()[0].(map[string]interface{})["secret_value"])
+	require.Equal(t, []interface{}{"dev"}, resourceData.Get("stage.1.after_stages"))
+	require.Equal(t, 2, resourceData.Get("scheduled_trigger.0.days_to_release").(*schema.Set).Len())
+
+	roundtrip, _, err := expandReleaseDefinition(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, toServiceModel(t, expanded), toServiceModel(t, roundtrip))
+}
+
+func TestReleaseDefinition_Flatten_KeepsConfiguredInputs(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, getReleaseDefinitionConfig())
+	expanded, projectID, err := expandReleaseDefinition(resourceData, nil)
+	require.Nil(t, err)
+
+	// the service adds the default values of all inputs of a task
+	serviceModel := toServiceModel(t, expanded)
+	environments := *serviceModel.Environments
+	for _, tasks := range []*[]release.WorkflowTask{
+		(*environments[1].PostDeploymentGates.Gates)[0].Tasks,
+		deployPhaseTasks(t, environments[0].DeployPhases),
+	} {
+		(*(*tasks)[0].Inputs)["workingDirectory"] = ""
+	}
+
+	require.Nil(t, flattenReleaseDefinition(resourceData, serviceModel, projectID))
+	require.Equal(t, map[string]interface{}{"script": "echo hello"}, resourceData.Get("stage.0.job.0.task.0.inputs"))
+	require.Equal(t, map[string]interface{}{"script": "echo hello"}, resourceData.Get("stage.1.post_deploy_gate.0.task.0.inputs"))
+}
+
+// deployPhaseTasks converts the first untyped deploy phase into a typed one and returns its tasks
+func deployPhaseTasks(t *testing.T, deployPhases *[]interface{}) *[]release.WorkflowTask {
+	var phase release.AgentBasedDeployPhase
+	require.Nil(t, remarshal((*deployPhases)[0], &phase))
+	(*deployPhases)[0] = phase
+	return phase.WorkflowTasks
+}
+
+func TestReleaseDefinition_Expand_ValidatesArtifactsAndStages(t *testing.T) {
+	config := getReleaseDefinitionConfig()
+	artifact := config["artifact"].([]interface{})[0].(map[string]interface{})
+	artifact["git"] = config["artifact"].([]interface{})[1].(map[string]interface{})["git"]
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, config)
+	_, _, err := expandReleaseDefinition(resourceData, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "artifact _build must specify exactly one of build, git or container")
+
+	config = getReleaseDefinitionConfig()
+	config["stage"].([]interface{})[1].(map[string]interface{})["after_stages"] = []interface{}{"test"}
+	resourceData = schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, config)
+	_, _, err = expandReleaseDefinition(resourceData, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "stage prod is triggered after unknown stage test")
+}
+
+func TestReleaseDefinition_Expand_KeepsStageIDs(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, getReleaseDefinitionConfig())
+	expanded, _, err := expandReleaseDefinition(resourceData, map[string]int{"prod": 4})
+	require.Nil(t, err)
+	require.Nil(t, (*expanded.Environments)[0].Id)
+	require.Equal(t, 4, *(*expanded.Environments)[1].Id)
+}
+
+func TestReleaseDefinition_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		CreateReleaseDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreateReleaseDefinition() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, getReleaseDefinitionConfig())
+	diags := resourceReleaseDefinitionCreate(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "CreateReleaseDefinition() Failed")
+}
+
+func TestReleaseDefinition_Read_RemovesDeletedDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetReleaseDefinition(clients.Ctx, release.GetReleaseDefinitionArgs{
+			Project:      converter.String(testReleaseDefinitionProjectID),
+			DefinitionId: converter.Int(7),
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, getReleaseDefinitionConfig())
+	resourceData.SetId("7")
+	diags := resourceReleaseDefinitionRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
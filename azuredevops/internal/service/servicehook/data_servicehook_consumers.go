@@ -0,0 +1,134 @@
+package servicehook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func DataServicehookConsumers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataServicehookConsumersRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"publisher_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Only return the consumers supporting events of this publisher",
+			},
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input_descriptors": genInputDescriptorsSchema(),
+						"actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"supported_event_types": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"input_descriptors": genInputDescriptorsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataServicehookConsumersRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	args := servicehooks.ListConsumersArgs{}
+	if v, ok := d.GetOk("publisher_id"); ok {
+		args.PublisherId = converter.String(v.(string))
+	}
+
+	consumers, err := clients.ServiceHooksClient.ListConsumers(clients.Ctx, args)
+	if err != nil {
+		return fmt.Errorf(" listing service hook consumers: %+v", err)
+	}
+
+	d.SetId("servicehook-consumers-" + uuid.New().String())
+	if err := d.Set("consumers", flattenServicehookConsumers(consumers)); err != nil {
+		return fmt.Errorf(" setting `consumers`: %+v", err)
+	}
+	return nil
+}
+
+func flattenServicehookConsumers(consumers *[]servicehooks.Consumer) []interface{} {
+	if consumers == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*consumers))
+	for _, consumer := range *consumers {
+		actions := []interface{}{}
+		if consumer.Actions != nil {
+			for _, action := range *consumer.Actions {
+				eventTypes := []interface{}{}
+				if action.SupportedEventTypes != nil {
+					for _, v := range *action.SupportedEventTypes {
+						eventTypes = append(eventTypes, v)
+					}
+				}
+				actions = append(actions, map[string]interface{}{
+					"id":                    converter.ToString(action.Id, ""),
+					"name":                  converter.ToString(action.Name, ""),
+					"description":           converter.ToString(action.Description, ""),
+					"supported_event_types": eventTypes,
+					"input_descriptors":     flattenInputDescriptors(action.InputDescriptors),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                converter.ToString(consumer.Id, ""),
+			"name":              converter.ToString(consumer.Name, ""),
+			"description":       converter.ToString(consumer.Description, ""),
+			"input_descriptors": flattenInputDescriptors(consumer.InputDescriptors),
+			"actions":           actions,
+		})
+	}
+	return results
+}
@@ -0,0 +1,64 @@
+//go:build (all || data_servicehook_consumers) && !exclude_subscriptions
+// +build all data_servicehook_consumers
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataConsumers = []servicehooks.Consumer{
+	{
+		Id: converter.String("webHooks"),
+		Actions: &[]servicehooks.ConsumerAction{
+			{
+				Id:                  converter.String("httpRequest"),
+				SupportedEventTypes: &[]string{"git.push"},
+				InputDescriptors: &[]forminput.InputDescriptor{
+					{Id: converter.String("url"), Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)}},
+					{Id: converter.String("basicAuthPassword"), IsConfidential: converter.Bool(true)},
+					{
+						Id:     converter.String("resourceDetailsToSend"),
+						Values: &forminput.InputValues{DefaultValue: converter.String("all")},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDataServicehookConsumers_Read_FiltersByPublisher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		ListConsumers(clients.Ctx, servicehooks.ListConsumersArgs{PublisherId: converter.String("tfs")}).
+		Return(&testDataConsumers, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookConsumers().Schema, map[string]interface{}{
+		"publisher_id": "tfs",
+	})
+	err := dataServicehookConsumersRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "webHooks", resourceData.Get("consumers.0.id"))
+	require.Equal(t, "httpRequest", resourceData.Get("consumers.0.actions.0.id"))
+	require.Equal(t, []interface{}{"git.push"}, resourceData.Get("consumers.0.actions.0.supported_event_types"))
+	require.Equal(t, true, resourceData.Get("consumers.0.actions.0.input_descriptors.1.confidential"))
+	require.Equal(t, "all", resourceData.Get("consumers.0.actions.0.input_descriptors.2.default_value"))
+}
@@ -0,0 +1,211 @@
+package servicehook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServicehookNotifications exposes the notifications sent by a subscription, including the requests which are
+// needed to replay failed notifications
+func DataServicehookNotifications() *schema.Resource {
+	return &schema.Resource{
+		Read: dataServicehookNotificationsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servicehooks.NotificationStatusValues.Queued),
+					string(servicehooks.NotificationStatusValues.Processing),
+					string(servicehooks.NotificationStatusValues.RequestInProgress),
+					string(servicehooks.NotificationStatusValues.Completed),
+				}, false),
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servicehooks.NotificationResultValues.Pending),
+					string(servicehooks.NotificationResultValues.Succeeded),
+					string(servicehooks.NotificationResultValues.Failed),
+					string(servicehooks.NotificationResultValues.Filtered),
+				}, false),
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"include_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"notifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"event_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_attempts": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"response": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataServicehookNotificationsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	subscriptionID, err := uuid.Parse(d.Get("subscription_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing subscription ID: %+v", err)
+	}
+
+	args := servicehooks.GetNotificationsArgs{
+		SubscriptionId: &subscriptionID,
+		MaxResults:     converter.Int(d.Get("max_results").(int)),
+	}
+	if v, ok := d.GetOk("status"); ok {
+		status := servicehooks.NotificationStatus(v.(string))
+		args.Status = &status
+	}
+	if v, ok := d.GetOk("result"); ok {
+		result := servicehooks.NotificationResult(v.(string))
+		args.Result = &result
+	}
+
+	notifications, err := clients.ServiceHooksClient.GetNotifications(clients.Ctx, args)
+	if err != nil {
+		return fmt.Errorf(" listing notifications of service hook subscription %s: %+v", subscriptionID, err)
+	}
+
+	// the list does not contain the request and the response sent to the consumer, they are only returned
+	// when the notifications are read one by one
+	if notifications != nil && d.Get("include_details").(bool) {
+		for i, notification := range *notifications {
+			if notification.Id == nil {
+				continue
+			}
+			detailed, err := clients.ServiceHooksClient.GetNotification(clients.Ctx, servicehooks.GetNotificationArgs{
+				SubscriptionId: &subscriptionID,
+				NotificationId: notification.Id,
+			})
+			if err != nil {
+				return fmt.Errorf(" reading notification %d of service hook subscription %s: %+v", *notification.Id, subscriptionID, err)
+			}
+			(*notifications)[i] = *detailed
+		}
+	}
+
+	d.SetId("servicehook-notifications-" + uuid.New().String())
+	if err := d.Set("notifications", flattenServicehookNotifications(notifications)); err != nil {
+		return fmt.Errorf(" setting `notifications`: %+v", err)
+	}
+	return nil
+}
+
+func flattenServicehookNotifications(notifications *[]servicehooks.Notification) []interface{} {
+	if notifications == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*notifications))
+	for _, notification := range *notifications {
+		result := map[string]interface{}{}
+		if notification.Id != nil {
+			result["id"] = *notification.Id
+		}
+		if notification.EventId != nil {
+			result["event_id"] = notification.EventId.String()
+		}
+		if notification.Status != nil {
+			result["status"] = string(*notification.Status)
+		}
+		if notification.Result != nil {
+			result["result"] = string(*notification.Result)
+		}
+		if notification.CreatedDate != nil {
+			result["created_date"] = notification.CreatedDate.Time.Format(time.RFC3339)
+		}
+		if notification.ModifiedDate != nil {
+			result["modified_date"] = notification.ModifiedDate.Time.Format(time.RFC3339)
+		}
+		if details := notification.Details; details != nil {
+			result["event_type"] = converter.ToString(details.EventType, "")
+			result["error_message"] = converter.ToString(details.ErrorMessage, "")
+			result["error_detail"] = converter.ToString(details.ErrorDetail, "")
+			result["request"] = converter.ToString(details.Request, "")
+			result["response"] = converter.ToString(details.Response, "")
+			if details.RequestAttempts != nil {
+				result["request_attempts"] = *details.RequestAttempts
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
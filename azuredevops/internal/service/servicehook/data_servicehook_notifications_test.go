@@ -0,0 +1,98 @@
+//go:build (all || data_servicehook_notifications) && !exclude_subscriptions
+// +build all data_servicehook_notifications
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataServicehookNotifications_Read_FiltersByResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	subscriptionID := uuid.New()
+	failed := servicehooks.NotificationResultValues.Failed
+	mockClient.
+		EXPECT().
+		GetNotifications(clients.Ctx, servicehooks.GetNotificationsArgs{
+			SubscriptionId: &subscriptionID,
+			MaxResults:     converter.Int(100),
+			Result:         &failed,
+		}).
+		Return(&[]servicehooks.Notification{{Id: converter.Int(7), Result: &failed}}, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		GetNotification(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookNotifications().Schema, map[string]interface{}{
+		"subscription_id": subscriptionID.String(),
+		"result":          "failed",
+	})
+	err := dataServicehookNotificationsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 1, resourceData.Get("notifications.#"))
+	require.Equal(t, 7, resourceData.Get("notifications.0.id"))
+	require.Equal(t, "failed", resourceData.Get("notifications.0.result"))
+	require.Equal(t, "", resourceData.Get("notifications.0.request"))
+}
+
+func TestDataServicehookNotifications_Read_IncludesDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	subscriptionID := uuid.New()
+	failed := servicehooks.NotificationResultValues.Failed
+	mockClient.
+		EXPECT().
+		GetNotifications(clients.Ctx, gomock.Any()).
+		Return(&[]servicehooks.Notification{{Id: converter.Int(7), Result: &failed}}, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		GetNotification(clients.Ctx, servicehooks.GetNotificationArgs{
+			SubscriptionId: &subscriptionID,
+			NotificationId: converter.Int(7),
+		}).
+		Return(&servicehooks.Notification{
+			Id:     converter.Int(7),
+			Result: &failed,
+			Details: &servicehooks.NotificationDetails{
+				EventType:       converter.String("git.push"),
+				ErrorMessage:    converter.String("The remote server returned an error: (500)"),
+				Request:         converter.String("POST https://example.com/push"),
+				RequestAttempts: converter.Int(3),
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookNotifications().Schema, map[string]interface{}{
+		"subscription_id": subscriptionID.String(),
+		"include_details": true,
+	})
+	err := dataServicehookNotificationsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "git.push", resourceData.Get("notifications.0.event_type"))
+	require.Equal(t, "POST https://example.com/push", resourceData.Get("notifications.0.request"))
+	require.Equal(t, 3, resourceData.Get("notifications.0.request_attempts"))
+	require.Equal(t, "The remote server returned an error: (500)", resourceData.Get("notifications.0.error_message"))
+}
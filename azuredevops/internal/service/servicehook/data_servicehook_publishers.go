@@ -0,0 +1,122 @@
+package servicehook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func DataServicehookPublishers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataServicehookPublishersRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"publishers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input_descriptors": genInputDescriptorsSchema(),
+						"supported_events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"supported_resource_versions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"input_descriptors": genInputDescriptorsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataServicehookPublishersRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	publishers, err := clients.ServiceHooksClient.ListPublishers(clients.Ctx, servicehooks.ListPublishersArgs{})
+	if err != nil {
+		return fmt.Errorf(" listing service hook publishers: %+v", err)
+	}
+
+	d.SetId("servicehook-publishers-" + uuid.New().String())
+	if err := d.Set("publishers", flattenServicehookPublishers(publishers)); err != nil {
+		return fmt.Errorf(" setting `publishers`: %+v", err)
+	}
+	return nil
+}
+
+func flattenServicehookPublishers(publishers *[]servicehooks.Publisher) []interface{} {
+	if publishers == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*publishers))
+	for _, publisher := range *publishers {
+		events := []interface{}{}
+		if publisher.SupportedEvents != nil {
+			for _, event := range *publisher.SupportedEvents {
+				resourceVersions := []interface{}{}
+				if event.SupportedResourceVersions != nil {
+					for _, v := range *event.SupportedResourceVersions {
+						resourceVersions = append(resourceVersions, v)
+					}
+				}
+				events = append(events, map[string]interface{}{
+					"id":                          converter.ToString(event.Id, ""),
+					"name":                        converter.ToString(event.Name, ""),
+					"description":                 converter.ToString(event.Description, ""),
+					"supported_resource_versions": resourceVersions,
+					"input_descriptors":           flattenInputDescriptors(event.InputDescriptors),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                converter.ToString(publisher.Id, ""),
+			"name":              converter.ToString(publisher.Name, ""),
+			"description":       converter.ToString(publisher.Description, ""),
+			"input_descriptors": flattenInputDescriptors(publisher.InputDescriptors),
+			"supported_events":  events,
+		})
+	}
+	return results
+}
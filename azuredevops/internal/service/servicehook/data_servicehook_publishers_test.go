@@ -0,0 +1,82 @@
+//go:build (all || data_servicehook_publishers) && !exclude_subscriptions
+// +build all data_servicehook_publishers
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataPublishers = []servicehooks.Publisher{
+	{
+		Id: converter.String("tfs"),
+		InputDescriptors: &[]forminput.InputDescriptor{
+			{Id: converter.String("projectId"), Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)}},
+		},
+		SupportedEvents: &[]servicehooks.EventTypeDescriptor{
+			{
+				Id:                        converter.String("git.push"),
+				SupportedResourceVersions: &[]string{"1.0"},
+				InputDescriptors: &[]forminput.InputDescriptor{
+					{Id: converter.String("repository")},
+					{Id: converter.String("branch")},
+				},
+			},
+		},
+	},
+}
+
+func TestDataServicehookPublishers_Read_FlattensInputDescriptors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		ListPublishers(clients.Ctx, servicehooks.ListPublishersArgs{}).
+		Return(&testDataPublishers, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookPublishers().Schema, nil)
+	err := dataServicehookPublishersRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEmpty(t, resourceData.Id())
+	require.Equal(t, "tfs", resourceData.Get("publishers.0.id"))
+	require.Equal(t, "projectId", resourceData.Get("publishers.0.input_descriptors.0.id"))
+	require.Equal(t, true, resourceData.Get("publishers.0.input_descriptors.0.required"))
+	require.Equal(t, "git.push", resourceData.Get("publishers.0.supported_events.0.id"))
+	require.Equal(t, []interface{}{"1.0"}, resourceData.Get("publishers.0.supported_events.0.supported_resource_versions"))
+	require.Equal(t, "branch", resourceData.Get("publishers.0.supported_events.0.input_descriptors.1.id"))
+}
+
+func TestDataServicehookPublishers_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		ListPublishers(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ListPublishers() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookPublishers().Schema, nil)
+	err := dataServicehookPublishersRead(resourceData, clients)
+	require.Contains(t, err.Error(), "ListPublishers() Failed")
+}
@@ -0,0 +1,161 @@
+package servicehook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func DataServicehookSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataServicehookSubscriptionsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"publisher_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"event_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"consumer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"consumer_action_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publisher_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publisher_inputs": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"consumer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consumer_action_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consumer_inputs": {
+							Type:      schema.TypeMap,
+							Computed:  true,
+							Sensitive: true,
+							Elem:      &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataServicehookSubscriptionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	args := servicehooks.ListSubscriptionsArgs{}
+	if v, ok := d.GetOk("publisher_id"); ok {
+		args.PublisherId = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("event_type"); ok {
+		args.EventType = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("consumer_id"); ok {
+		args.ConsumerId = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("consumer_action_id"); ok {
+		args.ConsumerActionId = converter.String(v.(string))
+	}
+
+	subscriptions, err := clients.ServiceHooksClient.ListSubscriptions(clients.Ctx, args)
+	if err != nil {
+		return fmt.Errorf(" listing service hook subscriptions: %+v", err)
+	}
+
+	d.SetId("servicehook-subscriptions-" + uuid.New().String())
+	if err := d.Set("subscriptions", flattenServicehookSubscriptions(subscriptions)); err != nil {
+		return fmt.Errorf(" setting `subscriptions`: %+v", err)
+	}
+	return nil
+}
+
+func flattenServicehookSubscriptions(subscriptions *[]servicehooks.Subscription) []interface{} {
+	if subscriptions == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*subscriptions))
+	for _, subscription := range *subscriptions {
+		result := map[string]interface{}{
+			"publisher_id":       converter.ToString(subscription.PublisherId, ""),
+			"event_type":         converter.ToString(subscription.EventType, ""),
+			"event_description":  converter.ToString(subscription.EventDescription, ""),
+			"consumer_id":        converter.ToString(subscription.ConsumerId, ""),
+			"consumer_action_id": converter.ToString(subscription.ConsumerActionId, ""),
+			"action_description": converter.ToString(subscription.ActionDescription, ""),
+			"resource_version":   converter.ToString(subscription.ResourceVersion, ""),
+		}
+		if subscription.Id != nil {
+			result["id"] = subscription.Id.String()
+		}
+		if subscription.Status != nil {
+			result["status"] = string(*subscription.Status)
+		}
+		if subscription.PublisherInputs != nil {
+			result["publisher_inputs"] = *subscription.PublisherInputs
+		}
+		if subscription.ConsumerInputs != nil {
+			result["consumer_inputs"] = *subscription.ConsumerInputs
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,92 @@
+package servicehook
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// genInputDescriptorsSchema returns the computed schema of the input descriptors of publishers, events, consumers and actions
+func genInputDescriptorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"input_mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"required": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"confidential": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"default_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"possible_values": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func flattenInputDescriptors(descriptors *[]forminput.InputDescriptor) []interface{} {
+	if descriptors == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*descriptors))
+	for _, descriptor := range *descriptors {
+		result := map[string]interface{}{
+			"id":           converter.ToString(descriptor.Id, ""),
+			"name":         converter.ToString(descriptor.Name, ""),
+			"description":  converter.ToString(descriptor.Description, ""),
+			"type":         converter.ToString(descriptor.Type, ""),
+			"confidential": converter.ToBool(descriptor.IsConfidential, false),
+			"required":     false,
+		}
+		if descriptor.InputMode != nil {
+			result["input_mode"] = string(*descriptor.InputMode)
+		}
+		if descriptor.Validation != nil {
+			result["required"] = converter.ToBool(descriptor.Validation.IsRequired, false)
+		}
+		if descriptor.Values != nil {
+			result["default_value"] = converter.ToString(descriptor.Values.DefaultValue, "")
+			if descriptor.Values.PossibleValues != nil {
+				possibleValues := make([]interface{}, 0, len(*descriptor.Values.PossibleValues))
+				for _, v := range *descriptor.Values.PossibleValues {
+					possibleValues = append(possibleValues, converter.ToString(v.Value, ""))
+				}
+				result["possible_values"] = possibleValues
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -4,19 +4,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 )
 
-var (
-	apiType2ResourceBlock = map[string]string{
-		"ms.vss-pipelines.run-state-changed-event":   "run_state_changed_event",
-		"ms.vss-pipelines.stage-state-changed-event": "stage_state_changed_event",
-	}
+// publisherEvent maps an event block of a resource to the event type of the publisher. The attributes of the block
+// are mapped to the publisher inputs of the subscription.
+type publisherEvent struct {
+	apiType string
+	inputs  map[string]string
+}
 
-	resourceBlock2ApiType = map[string]string{
-		"run_state_changed_event":   "ms.vss-pipelines.run-state-changed-event",
-		"stage_state_changed_event": "ms.vss-pipelines.stage-state-changed-event",
-	}
-)
+var pipelinesEvents = map[string]publisherEvent{
+	"stage_state_changed_event": {
+		apiType: "ms.vss-pipelines.stage-state-changed-event",
+		inputs: map[string]string{
+			"pipeline_id":         "pipelineId",
+			"stage_name":          "stageNameId",
+			"stage_state_filter":  "stageStateId",
+			"stage_result_filter": "stageResultId",
+		},
+	},
+	"run_state_changed_event": {
+		apiType: "ms.vss-pipelines.run-state-changed-event",
+		inputs: map[string]string{
+			"pipeline_id":       "pipelineId",
+			"run_state_filter":  "runStateId",
+			"run_result_filter": "runResultId",
+		},
+	},
+}
 
 func genPipelinesPublisherSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -84,47 +100,51 @@ func genPipelinesPublisherSchema() map[string]*schema.Schema {
 }
 
 func expandPipelinesEventConfig(d *schema.ResourceData) (map[string]string, string) {
+	return expandEventConfig(d, pipelinesEvents)
+}
+
+func flattenPipelinesEventConfig(subscription *servicehooks.Subscription) (string, []interface{}) {
+	return flattenEventConfig(subscription, pipelinesEvents)
+}
+
+// expandEventConfig returns the publisher inputs and the event type of the configured event block
+func expandEventConfig(d *schema.ResourceData, events map[string]publisherEvent) (map[string]string, string) {
 	eventConfig := make(map[string]string)
 	var eventType string
-	if inputsList := d.Get("stage_state_changed_event"); len(inputsList.([]interface{})) > 0 {
-		eventType = "stage_state_changed_event"
-		if inputs, ok := inputsList.([]interface{}); ok && inputs[0] != nil {
-			eventConfig["pipelineId"] = inputs[0].(map[string]interface{})["pipeline_id"].(string)
-			eventConfig["stageNameId"] = inputs[0].(map[string]interface{})["stage_name"].(string)
-			eventConfig["stageStateId"] = inputs[0].(map[string]interface{})["stage_state_filter"].(string)
-			eventConfig["stageResultId"] = inputs[0].(map[string]interface{})["stage_result_filter"].(string)
+	for block, event := range events {
+		inputsList, ok := d.Get(block).([]interface{})
+		if !ok || len(inputsList) == 0 {
+			continue
 		}
-	}
-	if inputsList := d.Get("run_state_changed_event"); len(inputsList.([]interface{})) > 0 {
-		eventType = "run_state_changed_event"
-		if inputs, ok := inputsList.([]interface{}); ok && inputs[0] != nil {
-			eventConfig["pipelineId"] = inputs[0].(map[string]interface{})["pipeline_id"].(string)
-			eventConfig["runStateId"] = inputs[0].(map[string]interface{})["run_state_filter"].(string)
-			eventConfig["runResultId"] = inputs[0].(map[string]interface{})["run_result_filter"].(string)
+		eventType = event.apiType
+		if inputs, ok := inputsList[0].(map[string]interface{}); ok {
+			for key, apiKey := range event.inputs {
+				eventConfig[apiKey] = inputs[key].(string)
+			}
 		}
 	}
 	eventConfig["projectId"] = d.Get("project_id").(string)
-	return eventConfig, resourceBlock2ApiType[eventType]
+	return eventConfig, eventType
 }
 
-func flattenPipelinesEventConfig(subscription *servicehooks.Subscription) (string, []interface{}) {
-	eventType := apiType2ResourceBlock[*subscription.EventType]
-	if isNilEventConfig(*subscription.PublisherInputs) {
+// flattenEventConfig returns the event block and its attributes for the event type and the publisher inputs of a subscription
+func flattenEventConfig(subscription *servicehooks.Subscription, events map[string]publisherEvent) (string, []interface{}) {
+	var eventType string
+	var event publisherEvent
+	for block, candidate := range events {
+		if candidate.apiType == converter.ToString(subscription.EventType, "") {
+			eventType, event = block, candidate
+			break
+		}
+	}
+	if subscription.PublisherInputs == nil || isNilEventConfig(*subscription.PublisherInputs) {
 		return eventType, []interface{}{nil}
 	}
-	event := *subscription.PublisherInputs
+	publisherInputs := *subscription.PublisherInputs
 	eventConfig := make(map[string]interface{})
-	eventConfig["pipeline_id"] = event["pipelineId"]
-	switch eventType {
-	case "stage_state_changed_event":
-		eventConfig["stage_name"] = event["stageNameId"]
-		eventConfig["stage_state_filter"] = event["stageStateId"]
-		eventConfig["stage_result_filter"] = event["stageResultId"]
-	case "run_state_changed_event":
-		eventConfig["run_state_filter"] = event["runStateId"]
-		eventConfig["run_result_filter"] = event["runResultId"]
+	for key, apiKey := range event.inputs {
+		eventConfig[key] = publisherInputs[apiKey]
 	}
-
 	return eventType, []interface{}{eventConfig}
 }
 
@@ -0,0 +1,185 @@
+package servicehook
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func ResourceServicehookServiceBusWorkItems() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The ID of the project",
+		},
+		"connection_string": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The connection string of the Service Bus namespace",
+		},
+		"queue_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"queue_name", "topic_name"},
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The name of the queue that will receive the events",
+		},
+		"topic_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The name of the topic that will receive the events",
+		},
+		"send_as_non_serialized_string": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Send the events as non-serialized strings instead of serialized .NET objects",
+		},
+	}
+
+	maps.Copy(resourceSchema, genTfsWorkItemPublisherSchema())
+
+	return &schema.Resource{
+		Create: resourceServicehookServiceBusWorkItemsCreate,
+		Read:   resourceServicehookServiceBusWorkItemsRead,
+		Update: resourceServicehookServiceBusWorkItemsUpdate,
+		Delete: resourceServicehookServiceBusWorkItemsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceServicehookServiceBusWorkItemsCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookServiceBusWorkItems(d)
+
+	createdSubscription, err := createSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(createdSubscription.Id.String())
+	return resourceServicehookServiceBusWorkItemsRead(d, m)
+}
+
+func resourceServicehookServiceBusWorkItemsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscriptionId, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing subscription ID %s: %+v", d.Id(), err)
+	}
+
+	subscription, err := getSubscription(clients, &subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	flattenServicehookServiceBusWorkItems(d, subscription)
+	return nil
+}
+
+func resourceServicehookServiceBusWorkItemsUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookServiceBusWorkItems(d)
+
+	parsedID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	subscription.Id = &parsedID
+
+	_, err = updateSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	return resourceServicehookServiceBusWorkItemsRead(d, m)
+}
+
+func resourceServicehookServiceBusWorkItemsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	return clients.ServiceHooksClient.DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{
+		SubscriptionId: converter.UUID(d.Id()),
+	})
+}
+
+func expandServicehookServiceBusWorkItems(d *schema.ResourceData) *servicehooks.Subscription {
+	publisherInputs, eventType := expandTfsWorkItemEventConfig(d)
+	consumerInputs := map[string]string{
+		"connectionString": d.Get("connection_string").(string),
+		"bypassSerializer": strconv.FormatBool(d.Get("send_as_non_serialized_string").(bool)),
+	}
+
+	consumerActionId := "serviceBusQueueSend"
+	if v, ok := d.GetOk("topic_name"); ok {
+		consumerActionId = "serviceBusTopicSend"
+		consumerInputs["topicName"] = v.(string)
+	} else {
+		consumerInputs["queueName"] = d.Get("queue_name").(string)
+	}
+
+	return &servicehooks.Subscription{
+		ConsumerActionId: &consumerActionId,
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs:   &consumerInputs,
+		EventType:        &eventType,
+		PublisherId:      converter.String("tfs"),
+		PublisherInputs:  &publisherInputs,
+		ResourceVersion:  converter.String("1.0"),
+	}
+}
+
+// flattenServicehookServiceBusWorkItems sets the state from the subscription. The connection string is confidential
+// and not returned by the service, so it is kept as configured.
+func flattenServicehookServiceBusWorkItems(d *schema.ResourceData, subscription *servicehooks.Subscription) {
+	eventType, eventConfig := flattenTfsWorkItemEventConfig(subscription)
+	d.Set(eventType, eventConfig)
+	if subscription.PublisherInputs != nil {
+		d.Set("project_id", (*subscription.PublisherInputs)["projectId"])
+	}
+
+	consumerInputs := map[string]string{}
+	if subscription.ConsumerInputs != nil {
+		consumerInputs = *subscription.ConsumerInputs
+	}
+	if v, ok := consumerInputs["queueName"]; ok && v != "" {
+		d.Set("queue_name", v)
+	}
+	if v, ok := consumerInputs["topicName"]; ok && v != "" {
+		d.Set("topic_name", v)
+	}
+	sendAsString, err := strconv.ParseBool(consumerInputs["bypassSerializer"])
+	if err != nil {
+		sendAsString = false
+	}
+	d.Set("send_as_non_serialized_string", sendAsString)
+}
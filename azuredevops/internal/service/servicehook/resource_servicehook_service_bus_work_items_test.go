@@ -0,0 +1,179 @@
+//go:build (all || resource_servicehook_service_bus_work_items) && !exclude_subscriptions
+// +build all resource_servicehook_service_bus_work_items
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var subscriptionServiceBusWorkItemsID = uuid.New()
+
+var testConnectionString = "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=secret"
+
+var testResourceSubscriptionServiceBusWorkItems = []servicehooks.Subscription{
+	{
+		Id:               &subscriptionServiceBusWorkItemsID,
+		ConsumerActionId: converter.String("serviceBusQueueSend"),
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs: &map[string]string{
+			"connectionString": testConnectionString,
+			"queueName":        "myqueue",
+			"bypassSerializer": "false",
+		},
+		EventType:   converter.String("workitem.created"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId": "myprojectid",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionServiceBusWorkItemsID,
+		ConsumerActionId: converter.String("serviceBusTopicSend"),
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs: &map[string]string{
+			"connectionString": testConnectionString,
+			"topicName":        "mytopic",
+			"bypassSerializer": "true",
+		},
+		EventType:   converter.String("workitem.updated"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":     "myprojectid",
+			"areaPath":      "myproject\\myarea",
+			"workItemType":  "Bug",
+			"changedFields": "System.State",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionServiceBusWorkItemsID,
+		ConsumerActionId: converter.String("serviceBusQueueSend"),
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs: &map[string]string{
+			"connectionString": testConnectionString,
+			"queueName":        "myqueue",
+			"bypassSerializer": "false",
+		},
+		EventType:   converter.String("workitem.commented"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":      "myprojectid",
+			"areaPath":       "",
+			"workItemType":   "User Story",
+			"commentPattern": "#sync",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+}
+
+func TestServicehookServiceBusWorkItems_FlattenExpandRoundTrip(t *testing.T) {
+	for _, subscription := range testResourceSubscriptionServiceBusWorkItems {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServicehookServiceBusWorkItems().Schema, nil)
+		resourceData.Set("connection_string", testConnectionString)
+		flattenServicehookServiceBusWorkItems(resourceData, &subscription)
+		subscriptionAfterRoundTrip := expandServicehookServiceBusWorkItems(resourceData)
+		subscriptionAfterRoundTrip.Id = subscription.Id
+
+		require.Equal(t, subscription, *subscriptionAfterRoundTrip)
+	}
+}
+
+func TestServicehookServiceBusWorkItems_Flatten_HandlesMissingInputs(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicehookServiceBusWorkItems().Schema, nil)
+	flattenServicehookServiceBusWorkItems(resourceData, &servicehooks.Subscription{
+		Id:               &subscriptionServiceBusWorkItemsID,
+		ConsumerActionId: converter.String("serviceBusQueueSend"),
+		ConsumerId:       converter.String("azureServiceBus"),
+		EventType:        converter.String("workitem.created"),
+		PublisherId:      converter.String("tfs"),
+	})
+
+	require.Equal(t, "", resourceData.Get("project_id"))
+	require.Equal(t, "", resourceData.Get("queue_name"))
+	require.False(t, resourceData.Get("send_as_non_serialized_string").(bool))
+}
+
+func TestServicehookServiceBusWorkItems_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookServiceBusWorkItems()
+	for _, subscription := range testResourceSubscriptionServiceBusWorkItems {
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+		resourceData.Set("connection_string", testConnectionString)
+		flattenServicehookServiceBusWorkItems(resourceData, &subscription)
+
+		mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+		clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+		subscription.Id = nil
+		expectedArgs := servicehooks.CreateSubscriptionArgs{Subscription: &subscription}
+
+		mockClient.
+			EXPECT().
+			CreateSubscription(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateSubscription() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateSubscription() Failed")
+	}
+}
+
+func TestServicehookServiceBusWorkItems_Read_RemovesDeletedSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookServiceBusWorkItems()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionServiceBusWorkItemsID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionServiceBusWorkItemsID}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestServicehookServiceBusWorkItems_Delete_DoestNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookServiceBusWorkItems()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionServiceBusWorkItemsID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{SubscriptionId: &subscriptionServiceBusWorkItemsID}).
+		Return(errors.New("DeleteSubscription() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteSubscription() Failed")
+}
@@ -55,12 +55,14 @@ func ResourceServicehookStorageQueuePipelines() *schema.Resource {
 	}
 
 	maps.Copy(resourceSchema, genPipelinesPublisherSchema())
+	maps.Copy(resourceSchema, genSubscriptionHealthSchema())
 
 	return &schema.Resource{
-		Create: resourceServicehookStorageQueuePipelinesCreate,
-		Read:   resourceServicehookStorageQueuePipelinesRead,
-		Update: resourceServicehookStorageQueuePipelinesUpdate,
-		Delete: resourceServicehookStorageQueuePipelinesDelete,
+		Create:        resourceServicehookStorageQueuePipelinesCreate,
+		Read:          resourceServicehookStorageQueuePipelinesRead,
+		Update:        resourceServicehookStorageQueuePipelinesUpdate,
+		Delete:        resourceServicehookStorageQueuePipelinesDelete,
+		CustomizeDiff: customizeDiffSubscriptionHealth,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -98,7 +100,7 @@ func resourceServicehookStorageQueuePipelinesRead(d *schema.ResourceData, m inte
 		return err
 	}
 	flattenServicehookStorageQueuePipelines(d, subscription, d.Get("account_key").(string))
-	return nil
+	return flattenSubscriptionHealth(d, clients, subscription)
 }
 
 func resourceServicehookStorageQueuePipelinesUpdate(d *schema.ResourceData, m interface{}) error {
@@ -113,6 +115,7 @@ func resourceServicehookStorageQueuePipelinesUpdate(d *schema.ResourceData, m in
 		return err
 	}
 	subscription.Id = &parsedID
+	subscription.Status = expandSubscriptionStatus(d)
 
 	_, err = updateSubscription(clients, subscription)
 	if err != nil {
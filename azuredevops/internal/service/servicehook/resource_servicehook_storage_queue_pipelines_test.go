@@ -7,11 +7,16 @@ package servicehook
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -175,6 +180,110 @@ func TestServicehookStorageQueuePipelines_Read_DoestNotSwallowError(t *testing.T
 	}
 }
 
+func TestServicehookStorageQueuePipelines_Read_ExposesSubscriptionHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookStorageQueuePipelines()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionStorageQueueID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	subscription := testResourceSubscriptionStorageQueue[0]
+	status := servicehooks.SubscriptionStatusValues.DisabledBySystem
+	retryDate := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	subscription.Status = &status
+	subscription.ProbationRetries = converter.ToPtr(byte(3))
+	subscription.LastProbationRetryDate = &azuredevops.Time{Time: retryDate}
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{SubscriptionId: subscription.Id}).
+		Return(&subscription, nil).
+		Times(1)
+
+	notificationStatus := servicehooks.NotificationStatusValues.Completed
+	notificationResult := servicehooks.NotificationResultValues.Failed
+	mockClient.
+		EXPECT().
+		GetNotifications(clients.Ctx, servicehooks.GetNotificationsArgs{
+			SubscriptionId: subscription.Id,
+			MaxResults:     converter.Int(recentNotificationsCount),
+		}).
+		Return(&[]servicehooks.Notification{
+			{
+				Id:          converter.Int(42),
+				Status:      &notificationStatus,
+				Result:      &notificationResult,
+				CreatedDate: &azuredevops.Time{Time: retryDate},
+				Details:     &servicehooks.NotificationDetails{ErrorMessage: converter.String("The queue does not exist")},
+			},
+		}, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "disabledBySystem", resourceData.Get("status"))
+	require.Equal(t, 3, resourceData.Get("probation_retries"))
+	require.Equal(t, "2024-01-02T03:04:05Z", resourceData.Get("last_probation_retry_date"))
+	require.Equal(t, 42, resourceData.Get("recent_notifications.0.id"))
+	require.Equal(t, "failed", resourceData.Get("recent_notifications.0.result"))
+	require.Equal(t, "The queue does not exist", resourceData.Get("recent_notifications.0.error_message"))
+}
+
+func TestServicehookStorageQueuePipelines_Diff_ReenablesDisabledSubscription(t *testing.T) {
+	accountKey := strings.Repeat("a", 64)
+	testCases := []struct {
+		name           string
+		autoReenable   bool
+		status         string
+		expectedStatus string
+	}{
+		{name: "disabled by system", autoReenable: true, status: "disabledBySystem", expectedStatus: "enabled"},
+		{name: "disabled by user", autoReenable: true, status: "disabledByUser", expectedStatus: ""},
+		{name: "auto re-enable off", autoReenable: false, status: "disabledBySystem", expectedStatus: ""},
+	}
+
+	r := ResourceServicehookStorageQueuePipelines()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: subscriptionStorageQueueID.String(),
+				Attributes: map[string]string{
+					"id":            subscriptionStorageQueueID.String(),
+					"project_id":    "00000000-0000-0000-0000-000000000001",
+					"account_name":  "myaccountname",
+					"account_key":   accountKey,
+					"queue_name":    "myqueue",
+					"visi_timeout":  "0",
+					"ttl":           "604800",
+					"auto_reenable": strconv.FormatBool(tc.autoReenable),
+					"status":        tc.status,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"project_id":    "00000000-0000-0000-0000-000000000001",
+				"account_name":  "myaccountname",
+				"account_key":   accountKey,
+				"queue_name":    "myqueue",
+				"auto_reenable": tc.autoReenable,
+			})
+
+			diff, err := r.Diff(context.Background(), state, config, nil)
+			require.Nil(t, err)
+			if tc.expectedStatus == "" {
+				require.True(t, diff == nil || diff.Attributes["status"] == nil)
+				return
+			}
+			require.NotNil(t, diff)
+			require.Equal(t, tc.status, diff.Attributes["status"].Old)
+			require.Equal(t, tc.expectedStatus, diff.Attributes["status"].New)
+		})
+	}
+}
+
 func TestServicehookStorageQueuePipelines_Delete_DoestNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,355 @@
+package servicehook
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// value returned by the service for confidential inputs
+const confidentialInputValue = "********"
+
+func ResourceServicehookSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServicehookSubscriptionCreate,
+		Read:   resourceServicehookSubscriptionRead,
+		Update: resourceServicehookSubscriptionUpdate,
+		Delete: resourceServicehookSubscriptionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"publisher_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The ID of the publisher, e.g. `tfs`",
+			},
+			"event_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The ID of the event type of the publisher, e.g. `git.push`",
+			},
+			"publisher_inputs": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The inputs of the publisher used to filter the events",
+			},
+			"consumer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The ID of the consumer, e.g. `webHooks`",
+			},
+			"consumer_action_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The ID of the action of the consumer, e.g. `httpRequest`",
+			},
+			"consumer_inputs": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The inputs of the consumer action",
+			},
+			"resource_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The version of the event resource sent to the consumer",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServicehookSubscriptionCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookSubscription(d)
+
+	if err := validateServicehookSubscription(clients, subscription); err != nil {
+		return err
+	}
+
+	createdSubscription, err := createSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(createdSubscription.Id.String())
+	return resourceServicehookSubscriptionRead(d, m)
+}
+
+func resourceServicehookSubscriptionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscriptionId, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing subscription ID %s: %+v", d.Id(), err)
+	}
+
+	subscription, err := getSubscription(clients, &subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading service hook subscription %s: %+v", d.Id(), err)
+	}
+
+	publisherDescriptors, consumerDescriptors, err := getSubscriptionInputDescriptors(clients, subscription)
+	if err != nil {
+		return err
+	}
+	flattenServicehookSubscription(d, subscription, publisherDescriptors, consumerDescriptors)
+	return nil
+}
+
+func resourceServicehookSubscriptionUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookSubscription(d)
+
+	if err := validateServicehookSubscription(clients, subscription); err != nil {
+		return err
+	}
+
+	parsedID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	subscription.Id = &parsedID
+
+	_, err = updateSubscription(clients, subscription)
+	if err != nil {
+		return fmt.Errorf(" updating service hook subscription %s: %+v", d.Id(), err)
+	}
+
+	return resourceServicehookSubscriptionRead(d, m)
+}
+
+func resourceServicehookSubscriptionDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	return clients.ServiceHooksClient.DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{
+		SubscriptionId: converter.UUID(d.Id()),
+	})
+}
+
+func expandServicehookSubscription(d *schema.ResourceData) *servicehooks.Subscription {
+	subscription := &servicehooks.Subscription{
+		PublisherId:      converter.String(d.Get("publisher_id").(string)),
+		EventType:        converter.String(d.Get("event_type").(string)),
+		PublisherInputs:  tfhelper.ExpandStringMap(d.Get("publisher_inputs").(map[string]interface{})),
+		ConsumerId:       converter.String(d.Get("consumer_id").(string)),
+		ConsumerActionId: converter.String(d.Get("consumer_action_id").(string)),
+		ConsumerInputs:   tfhelper.ExpandStringMap(d.Get("consumer_inputs").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("resource_version"); ok {
+		subscription.ResourceVersion = converter.String(v.(string))
+	}
+	return subscription
+}
+
+// flattenServicehookSubscription sets the state from the subscription. All inputs returned by the service are read
+// back, except the inputs the service adds on its own: inputs without a descriptor and inputs set to their default
+// value. Confidential inputs are not returned by the service and are kept as configured.
+func flattenServicehookSubscription(d *schema.ResourceData, subscription *servicehooks.Subscription, publisherDescriptors, consumerDescriptors []forminput.InputDescriptor) {
+	d.Set("publisher_id", subscription.PublisherId)
+	d.Set("event_type", subscription.EventType)
+	d.Set("consumer_id", subscription.ConsumerId)
+	d.Set("consumer_action_id", subscription.ConsumerActionId)
+	d.Set("resource_version", subscription.ResourceVersion)
+	if subscription.Status != nil {
+		d.Set("status", string(*subscription.Status))
+	}
+
+	d.Set("publisher_inputs", flattenInputs(d.Get("publisher_inputs").(map[string]interface{}), subscription.PublisherInputs, publisherDescriptors))
+	d.Set("consumer_inputs", flattenInputs(d.Get("consumer_inputs").(map[string]interface{}), subscription.ConsumerInputs, consumerDescriptors))
+}
+
+func flattenInputs(configured map[string]interface{}, inputs *map[string]string, descriptors []forminput.InputDescriptor) map[string]interface{} {
+	byId := map[string]forminput.InputDescriptor{}
+	for _, descriptor := range descriptors {
+		byId[converter.ToString(descriptor.Id, "")] = descriptor
+	}
+	isConfidential := func(key, value string) bool {
+		descriptor, ok := byId[key]
+		return value == confidentialInputValue || (ok && converter.ToBool(descriptor.IsConfidential, false))
+	}
+
+	returned := map[string]string{}
+	if inputs != nil {
+		returned = *inputs
+	}
+
+	flattened := map[string]interface{}{}
+	for key, value := range configured {
+		v, ok := returned[key]
+		switch {
+		case isConfidential(key, v):
+			flattened[key] = value
+		case ok:
+			flattened[key] = v
+		}
+	}
+	for key, value := range returned {
+		if _, ok := configured[key]; ok {
+			continue
+		}
+		descriptor, ok := byId[key]
+		if !ok || value == "" || isConfidential(key, value) {
+			continue
+		}
+		if descriptor.Values != nil && value == converter.ToString(descriptor.Values.DefaultValue, "") {
+			continue
+		}
+		flattened[key] = value
+	}
+	return flattened
+}
+
+// validateServicehookSubscription validates the event type and the inputs of the subscription against the
+// metadata of the publisher and the consumer.
+func validateServicehookSubscription(clients *client.AggregatedClient, subscription *servicehooks.Subscription) error {
+	publisherDescriptors, consumerDescriptors, err := getSubscriptionInputDescriptors(clients, subscription)
+	if err != nil {
+		return err
+	}
+	if err := validateInputs("publisher", *subscription.PublisherInputs, publisherDescriptors); err != nil {
+		return err
+	}
+	return validateInputs("consumer", *subscription.ConsumerInputs, consumerDescriptors)
+}
+
+// getSubscriptionInputDescriptors returns the publisher and the consumer input descriptors supported by the event type
+// and the consumer action of the subscription
+func getSubscriptionInputDescriptors(clients *client.AggregatedClient, subscription *servicehooks.Subscription) ([]forminput.InputDescriptor, []forminput.InputDescriptor, error) {
+	publisherId := *subscription.PublisherId
+	eventType := *subscription.EventType
+	publisher, err := clients.ServiceHooksClient.GetPublisher(clients.Ctx, servicehooks.GetPublisherArgs{
+		PublisherId: subscription.PublisherId,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, nil, fmt.Errorf(" Publisher %q does not exist", publisherId)
+		}
+		return nil, nil, fmt.Errorf(" reading publisher %q: %+v", publisherId, err)
+	}
+
+	var event *servicehooks.EventTypeDescriptor
+	var supportedEvents []string
+	if publisher.SupportedEvents != nil {
+		for i, e := range *publisher.SupportedEvents {
+			supportedEvents = append(supportedEvents, converter.ToString(e.Id, ""))
+			if strings.EqualFold(converter.ToString(e.Id, ""), eventType) {
+				event = &(*publisher.SupportedEvents)[i]
+			}
+		}
+	}
+	if event == nil {
+		return nil, nil, fmt.Errorf(" Event type %q is not supported by publisher %q. Supported event types: %s", eventType, publisherId, strings.Join(supportedEvents, ", "))
+	}
+
+	consumerId := *subscription.ConsumerId
+	actionId := *subscription.ConsumerActionId
+	consumer, err := clients.ServiceHooksClient.GetConsumer(clients.Ctx, servicehooks.GetConsumerArgs{
+		ConsumerId: subscription.ConsumerId,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, nil, fmt.Errorf(" Consumer %q does not exist", consumerId)
+		}
+		return nil, nil, fmt.Errorf(" reading consumer %q: %+v", consumerId, err)
+	}
+
+	var action *servicehooks.ConsumerAction
+	var supportedActions []string
+	if consumer.Actions != nil {
+		for i, a := range *consumer.Actions {
+			supportedActions = append(supportedActions, converter.ToString(a.Id, ""))
+			if strings.EqualFold(converter.ToString(a.Id, ""), actionId) {
+				action = &(*consumer.Actions)[i]
+			}
+		}
+	}
+	if action == nil {
+		return nil, nil, fmt.Errorf(" Action %q is not supported by consumer %q. Supported actions: %s", actionId, consumerId, strings.Join(supportedActions, ", "))
+	}
+	if action.SupportedEventTypes != nil && len(*action.SupportedEventTypes) > 0 && !containsFold(*action.SupportedEventTypes, eventType) {
+		return nil, nil, fmt.Errorf(" Event type %q is not supported by action %q of consumer %q", eventType, actionId, consumerId)
+	}
+
+	publisherDescriptors := append(inputDescriptors(publisher.InputDescriptors), inputDescriptors(event.InputDescriptors)...)
+	consumerDescriptors := append(inputDescriptors(consumer.InputDescriptors), inputDescriptors(action.InputDescriptors)...)
+	return publisherDescriptors, consumerDescriptors, nil
+}
+
+// validateInputs ensures that all inputs are known and that all required inputs without a default value are set
+func validateInputs(kind string, inputs map[string]string, descriptors []forminput.InputDescriptor) error {
+	known := map[string]bool{}
+	var knownIds []string
+	for _, descriptor := range descriptors {
+		id := converter.ToString(descriptor.Id, "")
+		known[id] = true
+		knownIds = append(knownIds, id)
+
+		required := descriptor.Validation != nil && converter.ToBool(descriptor.Validation.IsRequired, false)
+		hasDefault := descriptor.Values != nil && converter.ToString(descriptor.Values.DefaultValue, "") != ""
+		if required && !hasDefault && inputs[id] == "" {
+			return fmt.Errorf(" The %s input %q is required", kind, id)
+		}
+	}
+
+	var unknown []string
+	for key := range inputs {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		sort.Strings(knownIds)
+		return fmt.Errorf(" Unknown %s inputs: %s. Supported inputs: %s", kind, strings.Join(unknown, ", "), strings.Join(knownIds, ", "))
+	}
+	return nil
+}
+
+func inputDescriptors(descriptors *[]forminput.InputDescriptor) []forminput.InputDescriptor {
+	if descriptors == nil {
+		return nil
+	}
+	return *descriptors
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
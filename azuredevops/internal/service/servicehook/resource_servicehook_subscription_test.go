@@ -0,0 +1,285 @@
+//go:build (all || resource_servicehook_subscription) && !exclude_subscriptions
+// +build all resource_servicehook_subscription
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var subscriptionGenericID = uuid.New()
+
+var testPublisherTfs = servicehooks.Publisher{
+	Id: converter.String("tfs"),
+	InputDescriptors: &[]forminput.InputDescriptor{
+		{Id: converter.String("projectId"), Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)}},
+	},
+	SupportedEvents: &[]servicehooks.EventTypeDescriptor{
+		{
+			Id: converter.String("git.push"),
+			InputDescriptors: &[]forminput.InputDescriptor{
+				{Id: converter.String("repository")},
+				{Id: converter.String("branch")},
+			},
+		},
+	},
+}
+
+var testConsumerWebHooks = servicehooks.Consumer{
+	Id: converter.String("webHooks"),
+	Actions: &[]servicehooks.ConsumerAction{
+		{
+			Id:                  converter.String("httpRequest"),
+			SupportedEventTypes: &[]string{"git.push"},
+			InputDescriptors: &[]forminput.InputDescriptor{
+				{Id: converter.String("url"), Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)}},
+				{Id: converter.String("basicAuthPassword"), IsConfidential: converter.Bool(true)},
+				{
+					Id:         converter.String("resourceDetailsToSend"),
+					Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+					Values:     &forminput.InputValues{DefaultValue: converter.String("all")},
+				},
+			},
+		},
+	},
+}
+
+func getServicehookSubscriptionResourceData(t *testing.T, publisherInputs, consumerInputs map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceServicehookSubscription().Schema, map[string]interface{}{
+		"publisher_id":       "tfs",
+		"event_type":         "git.push",
+		"publisher_inputs":   publisherInputs,
+		"consumer_id":        "webHooks",
+		"consumer_action_id": "httpRequest",
+		"consumer_inputs":    consumerInputs,
+	})
+}
+
+func mockServicehookSubscriptionMetadata(mockClient *azdosdkmocks.MockServicehooksClient, ctx context.Context) {
+	mockClient.
+		EXPECT().
+		GetPublisher(ctx, servicehooks.GetPublisherArgs{PublisherId: converter.String("tfs")}).
+		Return(&testPublisherTfs, nil).
+		AnyTimes()
+	mockClient.
+		EXPECT().
+		GetConsumer(ctx, servicehooks.GetConsumerArgs{ConsumerId: converter.String("webHooks")}).
+		Return(&testConsumerWebHooks, nil).
+		AnyTimes()
+}
+
+func TestServicehookSubscription_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookSubscription()
+	resourceData := getServicehookSubscriptionResourceData(t,
+		map[string]interface{}{"projectId": "myprojectid", "branch": "main"},
+		map[string]interface{}{"url": "https://example.com/hook", "basicAuthPassword": "secret"})
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+	mockServicehookSubscriptionMetadata(mockClient, clients.Ctx)
+
+	mockClient.
+		EXPECT().
+		CreateSubscription(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args servicehooks.CreateSubscriptionArgs) (*servicehooks.Subscription, error) {
+			require.Equal(t, "git.push", *args.Subscription.EventType)
+			require.Equal(t, "main", (*args.Subscription.PublisherInputs)["branch"])
+			require.Equal(t, "secret", (*args.Subscription.ConsumerInputs)["basicAuthPassword"])
+			return nil, errors.New("CreateSubscription() Failed")
+		}).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateSubscription() Failed")
+}
+
+func TestServicehookSubscription_Create_ValidatesAgainstMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+	mockServicehookSubscriptionMetadata(mockClient, clients.Ctx)
+	mockClient.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).Times(0)
+
+	testCases := []struct {
+		name            string
+		eventType       string
+		publisherInputs map[string]interface{}
+		consumerInputs  map[string]interface{}
+		expectedError   string
+	}{
+		{
+			name:            "unsupported event type",
+			eventType:       "git.pullrequest.created",
+			publisherInputs: map[string]interface{}{"projectId": "myprojectid"},
+			consumerInputs:  map[string]interface{}{"url": "https://example.com/hook"},
+			expectedError:   `Event type "git.pullrequest.created" is not supported by publisher "tfs"`,
+		},
+		{
+			name:            "missing required publisher input",
+			eventType:       "git.push",
+			publisherInputs: map[string]interface{}{"branch": "main"},
+			consumerInputs:  map[string]interface{}{"url": "https://example.com/hook"},
+			expectedError:   `The publisher input "projectId" is required`,
+		},
+		{
+			name:            "unknown consumer input",
+			eventType:       "git.push",
+			publisherInputs: map[string]interface{}{"projectId": "myprojectid"},
+			consumerInputs:  map[string]interface{}{"url": "https://example.com/hook", "unknown": "value"},
+			expectedError:   "Unknown consumer inputs: unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceData := getServicehookSubscriptionResourceData(t, tc.publisherInputs, tc.consumerInputs)
+			resourceData.Set("event_type", tc.eventType)
+
+			err := ResourceServicehookSubscription().Create(resourceData, clients)
+			require.NotNil(t, err)
+			require.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+func TestServicehookSubscription_Read_KeepsConfiguredInputs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookSubscription()
+	resourceData := getServicehookSubscriptionResourceData(t,
+		map[string]interface{}{"projectId": "myprojectid"},
+		map[string]interface{}{"url": "https://example.com/hook", "basicAuthPassword": "secret"})
+	resourceData.SetId(subscriptionGenericID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+	mockServicehookSubscriptionMetadata(mockClient, clients.Ctx)
+
+	status := servicehooks.SubscriptionStatusValues.Enabled
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionGenericID}).
+		Return(&servicehooks.Subscription{
+			Id:               &subscriptionGenericID,
+			PublisherId:      converter.String("tfs"),
+			EventType:        converter.String("git.push"),
+			PublisherInputs:  &map[string]string{"projectId": "myprojectid", "tfsSubscriptionId": "generated"},
+			ConsumerId:       converter.String("webHooks"),
+			ConsumerActionId: converter.String("httpRequest"),
+			ConsumerInputs:   &map[string]string{"url": "https://example.com/changed", "basicAuthPassword": confidentialInputValue},
+			ResourceVersion:  converter.String("1.0"),
+			Status:           &status,
+		}, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"projectId": "myprojectid"}, resourceData.Get("publisher_inputs"))
+	require.Equal(t, map[string]interface{}{
+		"url":               "https://example.com/changed",
+		"basicAuthPassword": "secret",
+	}, resourceData.Get("consumer_inputs"))
+	require.Equal(t, "1.0", resourceData.Get("resource_version"))
+	require.Equal(t, "enabled", resourceData.Get("status"))
+}
+
+func TestServicehookSubscription_Read_ReadsBackInputsOnImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookSubscription()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionGenericID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+	mockServicehookSubscriptionMetadata(mockClient, clients.Ctx)
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionGenericID}).
+		Return(&servicehooks.Subscription{
+			Id:               &subscriptionGenericID,
+			PublisherId:      converter.String("tfs"),
+			EventType:        converter.String("git.push"),
+			PublisherInputs:  &map[string]string{"projectId": "myprojectid", "branch": "main", "repository": "", "tfsSubscriptionId": "generated"},
+			ConsumerId:       converter.String("webHooks"),
+			ConsumerActionId: converter.String("httpRequest"),
+			ConsumerInputs: &map[string]string{
+				"url":                   "https://example.com/hook",
+				"basicAuthPassword":     confidentialInputValue,
+				"resourceDetailsToSend": "all",
+			},
+		}, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"projectId": "myprojectid", "branch": "main"}, resourceData.Get("publisher_inputs"))
+	require.Equal(t, map[string]interface{}{"url": "https://example.com/hook"}, resourceData.Get("consumer_inputs"))
+	require.Equal(t, "git.push", resourceData.Get("event_type"))
+}
+
+func TestServicehookSubscription_Read_RemovesDeletedSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookSubscription()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionGenericID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionGenericID}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestServicehookSubscription_Delete_DoestNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookSubscription()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionGenericID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{SubscriptionId: &subscriptionGenericID}).
+		Return(errors.New("DeleteSubscription() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteSubscription() Failed")
+}
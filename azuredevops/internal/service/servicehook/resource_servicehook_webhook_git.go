@@ -0,0 +1,224 @@
+package servicehook
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func ResourceServicehookWebhookGit() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The ID of the project",
+		},
+		"url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL the events are sent to",
+		},
+		"basic_auth_username": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"basic_auth_password"},
+			Description:  "The username used for basic authentication",
+		},
+		"basic_auth_password": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"basic_auth_username"},
+			Description:  "The password used for basic authentication",
+		},
+		"http_headers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "HTTP headers to send with every request",
+		},
+		"resource_details_to_send": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "minimal", "none"}, false),
+			Description:  "The amount of resource details to send",
+		},
+		"messages_to_send": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "text", "html", "markdown", "none"}, false),
+			Description:  "The messages to send",
+		},
+		"detailed_messages_to_send": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "text", "html", "markdown", "none"}, false),
+			Description:  "The detailed messages to send",
+		},
+		"accept_untrusted_certs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Accept untrusted SSL certificates of the URL",
+		},
+	}
+
+	maps.Copy(resourceSchema, genTfsGitPublisherSchema())
+
+	return &schema.Resource{
+		Create: resourceServicehookWebhookGitCreate,
+		Read:   resourceServicehookWebhookGitRead,
+		Update: resourceServicehookWebhookGitUpdate,
+		Delete: resourceServicehookWebhookGitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceServicehookWebhookGitCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookWebhookGit(d)
+
+	createdSubscription, err := createSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(createdSubscription.Id.String())
+	return resourceServicehookWebhookGitRead(d, m)
+}
+
+func resourceServicehookWebhookGitRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscriptionId, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing subscription ID %s: %+v", d.Id(), err)
+	}
+
+	subscription, err := getSubscription(clients, &subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	flattenServicehookWebhookGit(d, subscription)
+	return nil
+}
+
+func resourceServicehookWebhookGitUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookWebhookGit(d)
+
+	parsedID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	subscription.Id = &parsedID
+
+	_, err = updateSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	return resourceServicehookWebhookGitRead(d, m)
+}
+
+func resourceServicehookWebhookGitDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	return clients.ServiceHooksClient.DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{
+		SubscriptionId: converter.UUID(d.Id()),
+	})
+}
+
+func expandServicehookWebhookGit(d *schema.ResourceData) *servicehooks.Subscription {
+	publisherInputs, eventType := expandTfsGitEventConfig(d)
+	consumerInputs := map[string]string{
+		"url":                    d.Get("url").(string),
+		"resourceDetailsToSend":  d.Get("resource_details_to_send").(string),
+		"messagesToSend":         d.Get("messages_to_send").(string),
+		"detailedMessagesToSend": d.Get("detailed_messages_to_send").(string),
+		"acceptUntrustedCerts":   strconv.FormatBool(d.Get("accept_untrusted_certs").(bool)),
+	}
+	if v, ok := d.GetOk("basic_auth_username"); ok {
+		consumerInputs["basicAuthUsername"] = v.(string)
+		consumerInputs["basicAuthPassword"] = d.Get("basic_auth_password").(string)
+	}
+	if v, ok := d.GetOk("http_headers"); ok {
+		consumerInputs["httpHeaders"] = expandHttpHeaders(v.(map[string]interface{}))
+	}
+
+	return &servicehooks.Subscription{
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs:   &consumerInputs,
+		EventType:        &eventType,
+		PublisherId:      converter.String("tfs"),
+		PublisherInputs:  &publisherInputs,
+		ResourceVersion:  converter.String("1.0"),
+	}
+}
+
+// flattenServicehookWebhookGit sets the state from the subscription. The basic authentication password and the
+// HTTP headers are confidential and not returned by the service, so they are kept as configured.
+func flattenServicehookWebhookGit(d *schema.ResourceData, subscription *servicehooks.Subscription) {
+	eventType, eventConfig := flattenTfsGitEventConfig(subscription)
+	d.Set(eventType, eventConfig)
+	d.Set("project_id", (*subscription.PublisherInputs)["projectId"])
+
+	consumerInputs := *subscription.ConsumerInputs
+	d.Set("url", consumerInputs["url"])
+	d.Set("basic_auth_username", consumerInputs["basicAuthUsername"])
+	if v, ok := consumerInputs["resourceDetailsToSend"]; ok {
+		d.Set("resource_details_to_send", v)
+	}
+	if v, ok := consumerInputs["messagesToSend"]; ok {
+		d.Set("messages_to_send", v)
+	}
+	if v, ok := consumerInputs["detailedMessagesToSend"]; ok {
+		d.Set("detailed_messages_to_send", v)
+	}
+	acceptUntrustedCerts, err := strconv.ParseBool(consumerInputs["acceptUntrustedCerts"])
+	if err != nil {
+		acceptUntrustedCerts = false
+	}
+	d.Set("accept_untrusted_certs", acceptUntrustedCerts)
+}
+
+// expandHttpHeaders converts the headers to the `Key:Value` lines expected by the web hooks consumer
+func expandHttpHeaders(headers map[string]interface{}) string {
+	lines := make([]string, 0, len(headers))
+	for k, v := range headers {
+		lines = append(lines, fmt.Sprintf("%s:%s", k, v.(string)))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,193 @@
+//go:build (all || resource_servicehook_webhook_git) && !exclude_subscriptions
+// +build all resource_servicehook_webhook_git
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var subscriptionWebhookGitID = uuid.New()
+
+var testResourceSubscriptionWebhookGit = []servicehooks.Subscription{
+	{
+		Id:               &subscriptionWebhookGitID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/hook",
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "all",
+			"detailedMessagesToSend": "all",
+			"acceptUntrustedCerts":   "false",
+		},
+		EventType:   converter.String("git.push"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId": "myprojectid",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionWebhookGitID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/hook",
+			"resourceDetailsToSend":  "minimal",
+			"messagesToSend":         "none",
+			"detailedMessagesToSend": "none",
+			"acceptUntrustedCerts":   "true",
+		},
+		EventType:   converter.String("git.pullrequest.updated"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":                    "myprojectid",
+			"repository":                   "myrepositoryid",
+			"branch":                       "main",
+			"pullrequestCreatedBy":         "mygroup",
+			"pullrequestReviewersContains": "myreviewers",
+			"notificationType":             "PushNotification",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionWebhookGitID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/hook",
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "all",
+			"detailedMessagesToSend": "all",
+			"acceptUntrustedCerts":   "false",
+		},
+		EventType:   converter.String("git.pullrequest.merged"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":                    "myprojectid",
+			"repository":                   "myrepositoryid",
+			"branch":                       "",
+			"pullrequestCreatedBy":         "",
+			"pullrequestReviewersContains": "",
+			"mergeResult":                  "Conflicts",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+}
+
+func TestServicehookWebhookGit_FlattenExpandRoundTrip(t *testing.T) {
+	for _, subscription := range testResourceSubscriptionWebhookGit {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServicehookWebhookGit().Schema, nil)
+		flattenServicehookWebhookGit(resourceData, &subscription)
+		subscriptionAfterRoundTrip := expandServicehookWebhookGit(resourceData)
+		subscriptionAfterRoundTrip.Id = subscription.Id
+
+		require.Equal(t, subscription, *subscriptionAfterRoundTrip)
+	}
+}
+
+func TestServicehookWebhookGit_Expand_AuthenticationAndHeaders(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicehookWebhookGit().Schema, map[string]interface{}{
+		"project_id":          "myprojectid",
+		"url":                 "https://example.com/hook",
+		"basic_auth_username": "user",
+		"basic_auth_password": "secret",
+		"http_headers": map[string]interface{}{
+			"X-Second": "2",
+			"X-First":  "1",
+		},
+		"git_push": []interface{}{
+			map[string]interface{}{"branch": "main"},
+		},
+	})
+
+	subscription := expandServicehookWebhookGit(resourceData)
+	require.Equal(t, "git.push", *subscription.EventType)
+	require.Equal(t, "main", (*subscription.PublisherInputs)["branch"])
+	require.Equal(t, "user", (*subscription.ConsumerInputs)["basicAuthUsername"])
+	require.Equal(t, "secret", (*subscription.ConsumerInputs)["basicAuthPassword"])
+	require.Equal(t, "X-First:1\nX-Second:2", (*subscription.ConsumerInputs)["httpHeaders"])
+}
+
+func TestServicehookWebhookGit_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhookGit()
+	for _, subscription := range testResourceSubscriptionWebhookGit {
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+		flattenServicehookWebhookGit(resourceData, &subscription)
+
+		mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+		clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+		subscription.Id = nil
+		expectedArgs := servicehooks.CreateSubscriptionArgs{Subscription: &subscription}
+
+		mockClient.
+			EXPECT().
+			CreateSubscription(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateSubscription() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateSubscription() Failed")
+	}
+}
+
+func TestServicehookWebhookGit_Read_RemovesDeletedSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhookGit()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionWebhookGitID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionWebhookGitID}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestServicehookWebhookGit_Delete_DoestNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhookGit()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionWebhookGitID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{SubscriptionId: &subscriptionWebhookGitID}).
+		Return(errors.New("DeleteSubscription() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteSubscription() Failed")
+}
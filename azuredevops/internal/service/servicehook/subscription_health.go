@@ -0,0 +1,150 @@
+package servicehook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// number of notifications exposed in `recent_notifications`
+const recentNotificationsCount = 10
+
+// genSubscriptionHealthSchema returns the schema exposing the status, the probation state and the recent notification
+// results of a subscription, and the option to re-enable a subscription disabled by Azure DevOps
+func genSubscriptionHealthSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"auto_reenable": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Re-enable the subscription when it was disabled by Azure DevOps after repeated failures",
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"probation_retries": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"last_probation_retry_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"recent_notifications": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"result": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"created_date": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"error_message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffSubscriptionHealth reports a subscription disabled by Azure DevOps as drift if it should be re-enabled
+func customizeDiffSubscriptionHealth(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.Get("auto_reenable").(bool) {
+		return nil
+	}
+	if d.Get("status").(string) == string(servicehooks.SubscriptionStatusValues.DisabledBySystem) {
+		return d.SetNew("status", string(servicehooks.SubscriptionStatusValues.Enabled))
+	}
+	return nil
+}
+
+// expandSubscriptionStatus returns the status to be set on the subscription, which is only the case when it is re-enabled
+func expandSubscriptionStatus(d *schema.ResourceData) *servicehooks.SubscriptionStatus {
+	if !d.HasChange("status") {
+		return nil
+	}
+	if d.Get("status").(string) != string(servicehooks.SubscriptionStatusValues.Enabled) {
+		return nil
+	}
+	status := servicehooks.SubscriptionStatusValues.Enabled
+	return &status
+}
+
+func flattenSubscriptionHealth(d *schema.ResourceData, clients *client.AggregatedClient, subscription *servicehooks.Subscription) error {
+	if subscription.Status != nil {
+		d.Set("status", string(*subscription.Status))
+	}
+	probationRetries := 0
+	if subscription.ProbationRetries != nil {
+		probationRetries = int(*subscription.ProbationRetries)
+	}
+	d.Set("probation_retries", probationRetries)
+	lastProbationRetryDate := ""
+	if subscription.LastProbationRetryDate != nil {
+		lastProbationRetryDate = subscription.LastProbationRetryDate.Time.Format(time.RFC3339)
+	}
+	d.Set("last_probation_retry_date", lastProbationRetryDate)
+
+	notifications, err := getSubscriptionNotifications(clients, subscription.Id)
+	if err != nil {
+		return fmt.Errorf(" reading notifications of subscription %s: %+v", subscription.Id, err)
+	}
+	d.Set("recent_notifications", flattenSubscriptionNotifications(notifications))
+	return nil
+}
+
+func getSubscriptionNotifications(clients *client.AggregatedClient, subscriptionID *uuid.UUID) (*[]servicehooks.Notification, error) {
+	return clients.ServiceHooksClient.GetNotifications(clients.Ctx, servicehooks.GetNotificationsArgs{
+		SubscriptionId: subscriptionID,
+		MaxResults:     converter.Int(recentNotificationsCount),
+	})
+}
+
+func flattenSubscriptionNotifications(notifications *[]servicehooks.Notification) []interface{} {
+	if notifications == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*notifications))
+	for _, notification := range *notifications {
+		result := map[string]interface{}{}
+		if notification.Id != nil {
+			result["id"] = *notification.Id
+		}
+		if notification.Status != nil {
+			result["status"] = string(*notification.Status)
+		}
+		if notification.Result != nil {
+			result["result"] = string(*notification.Result)
+		}
+		if notification.CreatedDate != nil {
+			result["created_date"] = notification.CreatedDate.Time.Format(time.RFC3339)
+		}
+		if notification.Details != nil {
+			result["error_message"] = converter.ToString(notification.Details.ErrorMessage, "")
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,281 @@
+package servicehook
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+)
+
+var (
+	tfsGitEvents = map[string]publisherEvent{
+		"git_push": {
+			apiType: "git.push",
+			inputs: map[string]string{
+				"repository_id": "repository",
+				"branch":        "branch",
+				"pushed_by":     "pushedBy",
+			},
+		},
+		"git_pull_request_created": {
+			apiType: "git.pullrequest.created",
+			inputs: map[string]string{
+				"repository_id":      "repository",
+				"branch":             "branch",
+				"created_by":         "pullrequestCreatedBy",
+				"reviewers_contains": "pullrequestReviewersContains",
+			},
+		},
+		"git_pull_request_updated": {
+			apiType: "git.pullrequest.updated",
+			inputs: map[string]string{
+				"repository_id":      "repository",
+				"branch":             "branch",
+				"created_by":         "pullrequestCreatedBy",
+				"reviewers_contains": "pullrequestReviewersContains",
+				"notification_type":  "notificationType",
+			},
+		},
+		"git_pull_request_merge_attempted": {
+			apiType: "git.pullrequest.merged",
+			inputs: map[string]string{
+				"repository_id":      "repository",
+				"branch":             "branch",
+				"created_by":         "pullrequestCreatedBy",
+				"reviewers_contains": "pullrequestReviewersContains",
+				"merge_result":       "mergeResult",
+			},
+		},
+	}
+
+	tfsWorkItemEvents = map[string]publisherEvent{
+		"work_item_created": {
+			apiType: "workitem.created",
+			inputs: map[string]string{
+				"area_path":      "areaPath",
+				"work_item_type": "workItemType",
+			},
+		},
+		"work_item_updated": {
+			apiType: "workitem.updated",
+			inputs: map[string]string{
+				"area_path":      "areaPath",
+				"work_item_type": "workItemType",
+				"changed_fields": "changedFields",
+			},
+		},
+		"work_item_commented": {
+			apiType: "workitem.commented",
+			inputs: map[string]string{
+				"area_path":       "areaPath",
+				"work_item_type":  "workItemType",
+				"comment_pattern": "commentPattern",
+			},
+		},
+	}
+)
+
+func genTfsGitPublisherSchema() map[string]*schema.Schema {
+	eventBlocks := []string{
+		"git_push",
+		"git_pull_request_created",
+		"git_pull_request_updated",
+		"git_pull_request_merge_attempted",
+	}
+
+	repositoryID := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The ID of the repository to be monitored. If not specified, all repositories in the project will trigger the event",
+		}
+	}
+	branch := func() *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The branch to be monitored. If not specified, all branches will trigger the event",
+		}
+	}
+	createdBy := func() *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only pull requests created by this group will trigger the event",
+		}
+	}
+	reviewersContains := func() *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only pull requests with this group as reviewer will trigger the event",
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"git_push": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository_id": repositoryID(),
+					"branch":        branch(),
+					"pushed_by": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only pushes by this group will trigger the event",
+					},
+				},
+			},
+		},
+		"git_pull_request_created": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository_id":      repositoryID(),
+					"branch":             branch(),
+					"created_by":         createdBy(),
+					"reviewers_contains": reviewersContains(),
+				},
+			},
+		},
+		"git_pull_request_updated": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository_id":      repositoryID(),
+					"branch":             branch(),
+					"created_by":         createdBy(),
+					"reviewers_contains": reviewersContains(),
+					"notification_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"PushNotification",
+							"ReviewersUpdateNotification",
+							"StatusUpdateNotification",
+							"ReviewerVoteNotification",
+						}, false),
+						Description: "Which change of the pull request should generate an event. If not specified, all changes will trigger the event",
+					},
+				},
+			},
+		},
+		"git_pull_request_merge_attempted": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository_id":      repositoryID(),
+					"branch":             branch(),
+					"created_by":         createdBy(),
+					"reviewers_contains": reviewersContains(),
+					"merge_result": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"Succeeded", "Unsuccessful", "Conflicts", "Failure", "RejectedByPolicy"}, false),
+						Description:  "Which merge result should generate an event. If not specified, all results will trigger the event",
+					},
+				},
+			},
+		},
+	}
+}
+
+func genTfsWorkItemPublisherSchema() map[string]*schema.Schema {
+	eventBlocks := []string{
+		"work_item_created",
+		"work_item_updated",
+		"work_item_commented",
+	}
+
+	areaPath := func() *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The area path to be monitored, including all child areas. If not specified, all areas in the project will trigger the event",
+		}
+	}
+	workItemType := func() *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The work item type to be monitored, e.g. `Bug`. If not specified, all work item types will trigger the event",
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"work_item_created": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"area_path":      areaPath(),
+					"work_item_type": workItemType(),
+				},
+			},
+		},
+		"work_item_updated": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"area_path":      areaPath(),
+					"work_item_type": workItemType(),
+					"changed_fields": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only changes of this field will trigger the event, e.g. `System.State`. If not specified, all changes will trigger the event",
+					},
+				},
+			},
+		},
+		"work_item_commented": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: eventBlocks,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"area_path":      areaPath(),
+					"work_item_type": workItemType(),
+					"comment_pattern": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only comments containing this string will trigger the event. If not specified, all comments will trigger the event",
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandTfsGitEventConfig(d *schema.ResourceData) (map[string]string, string) {
+	return expandEventConfig(d, tfsGitEvents)
+}
+
+func flattenTfsGitEventConfig(subscription *servicehooks.Subscription) (string, []interface{}) {
+	return flattenEventConfig(subscription, tfsGitEvents)
+}
+
+func expandTfsWorkItemEventConfig(d *schema.ResourceData) (map[string]string, string) {
+	return expandEventConfig(d, tfsWorkItemEvents)
+}
+
+func flattenTfsWorkItemEventConfig(subscription *servicehooks.Subscription) (string, []interface{}) {
+	return flattenEventConfig(subscription, tfsWorkItemEvents)
+}
@@ -0,0 +1,124 @@
+package taskagent
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataDeploymentGroup schema and implementation for deployment group data source
+func DataDeploymentGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeploymentGroupRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"deployment_group_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"name"},
+				AtLeastOneOf:  []string{"deployment_group_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"machine_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"machine_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	var deploymentGroup *taskagent.DeploymentGroup
+	if v, ok := d.GetOk("deployment_group_id"); ok {
+		var err error
+		deploymentGroup, err = clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+			Project:           converter.String(projectID),
+			DeploymentGroupId: converter.Int(v.(int)),
+			Expand:            &taskagent.DeploymentGroupExpandsValues.Tags,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return diag.Errorf(" Deployment group with ID %d does not exist in project %s", v.(int), projectID)
+			}
+			return diag.Errorf(" reading deployment group %d: %+v", v.(int), err)
+		}
+	} else {
+		name := d.Get("name").(string)
+		response, err := clients.TaskAgentClient.GetDeploymentGroups(clients.Ctx, taskagent.GetDeploymentGroupsArgs{
+			Project: converter.String(projectID),
+			Name:    converter.String(name),
+			Expand:  &taskagent.DeploymentGroupExpandsValues.Tags,
+		})
+		if err != nil {
+			return diag.Errorf(" reading deployment groups: %+v", err)
+		}
+		for _, candidate := range response.Value {
+			if strings.EqualFold(converter.ToString(candidate.Name, ""), name) {
+				candidate := candidate
+				deploymentGroup = &candidate
+				break
+			}
+		}
+		if deploymentGroup == nil {
+			return diag.Errorf(" Unable to find deployment group with name: %s", name)
+		}
+	}
+
+	if deploymentGroup == nil || deploymentGroup.Id == nil {
+		return diag.Errorf(" The deployment group returned by the service does not have an ID")
+	}
+
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	d.Set("deployment_group_id", *deploymentGroup.Id)
+	flattenDeploymentGroup(d, deploymentGroup)
+	if deploymentGroup.MachineTags != nil {
+		d.Set("machine_tags", *deploymentGroup.MachineTags)
+	} else {
+		d.Set("machine_tags", nil)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// DataDeploymentGroupTargets schema and implementation for deployment group targets data source
+func DataDeploymentGroupTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeploymentGroupTargetsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"deployment_group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Set: schema.HashString,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentGroupTargetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	deploymentGroupID := d.Get("deployment_group_id").(int)
+
+	args := taskagent.GetDeploymentTargetsArgs{
+		Project:           converter.String(projectID),
+		DeploymentGroupId: converter.Int(deploymentGroupID),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		args.Name = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		tags := tfhelper.ExpandStringSet(v.(*schema.Set))
+		args.Tags = &tags
+	}
+
+	targets, err := getDeploymentTargets(clients, args)
+	if err != nil {
+		return diag.Errorf(" reading targets of deployment group %d: %+v", deploymentGroupID, err)
+	}
+
+	d.SetId(fmt.Sprintf("deployment-group-targets-%s-%d", projectID, deploymentGroupID))
+	if err := d.Set("targets", flattenDeploymentTargets(targets)); err != nil {
+		return diag.Errorf(" setting targets of deployment group %d: %+v", deploymentGroupID, err)
+	}
+	return nil
+}
+
+// getDeploymentTargets reads all pages of deployment targets matching the arguments
+func getDeploymentTargets(clients *client.AggregatedClient, args taskagent.GetDeploymentTargetsArgs) ([]taskagent.DeploymentMachine, error) {
+	var targets []taskagent.DeploymentMachine
+	for {
+		response, err := clients.TaskAgentClient.GetDeploymentTargets(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, response.Value...)
+		if response.ContinuationToken == "" {
+			return targets, nil
+		}
+		args.ContinuationToken = converter.String(response.ContinuationToken)
+	}
+}
+
+func flattenDeploymentTargets(targets []taskagent.DeploymentMachine) []interface{} {
+	sort.Slice(targets, func(i, j int) bool {
+		return converter.ToInt(targets[i].Id, 0) < converter.ToInt(targets[j].Id, 0)
+	})
+
+	results := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		result := map[string]interface{}{
+			"id":   converter.ToInt(target.Id, 0),
+			"tags": []string{},
+		}
+		if target.Tags != nil {
+			result["tags"] = *target.Tags
+		}
+		if target.Agent != nil {
+			result["name"] = converter.ToString(target.Agent.Name, "")
+			result["enabled"] = converter.ToBool(target.Agent.Enabled, false)
+			result["os_description"] = converter.ToString(target.Agent.OsDescription, "")
+			result["version"] = converter.ToString(target.Agent.Version, "")
+			if target.Agent.Status != nil {
+				result["status"] = string(*target.Agent.Status)
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
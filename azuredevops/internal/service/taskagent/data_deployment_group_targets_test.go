@@ -0,0 +1,80 @@
+//go:build (all || data_sources || data_deployment_group_targets) && (!exclude_data_sources || !exclude_data_deployment_group_targets)
+// +build all data_sources data_deployment_group_targets
+// +build !exclude_data_sources !exclude_data_deployment_group_targets
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceDeploymentGroupTargets_Read_ReadsAllPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	online := taskagent.TaskAgentStatusValues.Online
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentTargets(clients.Ctx, taskagent.GetDeploymentTargetsArgs{
+			Project:           converter.String(projectID),
+			DeploymentGroupId: converter.Int(12),
+			Tags:              &[]string{"web"},
+		}).
+		Return(&taskagent.GetDeploymentTargetsResponseValue{
+			Value: []taskagent.DeploymentMachine{{
+				Id:   converter.Int(7),
+				Tags: &[]string{"web", "prod"},
+				Agent: &taskagent.TaskAgent{
+					Name:    converter.String("vm-07"),
+					Enabled: converter.Bool(true),
+					Status:  &online,
+				},
+			}},
+			ContinuationToken: "vm-07",
+		}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentTargets(clients.Ctx, taskagent.GetDeploymentTargetsArgs{
+			Project:           converter.String(projectID),
+			DeploymentGroupId: converter.Int(12),
+			Tags:              &[]string{"web"},
+			ContinuationToken: converter.String("vm-07"),
+		}).
+		Return(&taskagent.GetDeploymentTargetsResponseValue{
+			Value: []taskagent.DeploymentMachine{{
+				Id:    converter.Int(3),
+				Agent: &taskagent.TaskAgent{Name: converter.String("vm-03")},
+			}},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroupTargets().Schema, map[string]interface{}{
+		"project_id":          projectID,
+		"deployment_group_id": 12,
+		"tags":                []interface{}{"web"},
+	})
+	diags := dataSourceDeploymentGroupTargetsRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, 2, resourceData.Get("targets.#"))
+	require.Equal(t, 3, resourceData.Get("targets.0.id"))
+	require.Equal(t, "vm-03", resourceData.Get("targets.0.name"))
+	require.Equal(t, 7, resourceData.Get("targets.1.id"))
+	require.Equal(t, "online", resourceData.Get("targets.1.status"))
+	require.True(t, resourceData.Get("targets.1.enabled").(bool))
+	require.Equal(t, 2, resourceData.Get("targets.1.tags.#"))
+}
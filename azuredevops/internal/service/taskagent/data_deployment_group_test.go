@@ -0,0 +1,102 @@
+//go:build (all || data_sources || data_deployment_group) && (!exclude_data_sources || !exclude_data_deployment_group)
+// +build all data_sources data_deployment_group
+// +build !exclude_data_sources !exclude_data_deployment_group
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataDeploymentGroupProjectID = uuid.New().String()
+
+func TestDataSourceDeploymentGroup_Read_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroups(clients.Ctx, taskagent.GetDeploymentGroupsArgs{
+			Project: converter.String(testDataDeploymentGroupProjectID),
+			Name:    converter.String("web-servers"),
+			Expand:  &taskagent.DeploymentGroupExpandsValues.Tags,
+		}).
+		Return(&taskagent.GetDeploymentGroupsResponseValue{
+			Value: []taskagent.DeploymentGroup{{
+				Id:          converter.Int(12),
+				Name:        converter.String("Web-Servers"),
+				Pool:        &taskagent.TaskAgentPoolReference{Id: converter.Int(34), Name: converter.String("web-pool")},
+				MachineTags: &[]string{"web", "prod"},
+			}},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroup().Schema, map[string]interface{}{
+		"project_id": testDataDeploymentGroupProjectID,
+		"name":       "web-servers",
+	})
+	diags := dataSourceDeploymentGroupRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "12", resourceData.Id())
+	require.Equal(t, 12, resourceData.Get("deployment_group_id"))
+	require.Equal(t, 34, resourceData.Get("pool_id"))
+	require.ElementsMatch(t, []interface{}{"web", "prod"}, resourceData.Get("machine_tags").(*schema.Set).List())
+}
+
+func TestDataSourceDeploymentGroup_Read_ErrorsWhenNameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroups(clients.Ctx, gomock.Any()).
+		Return(&taskagent.GetDeploymentGroupsResponseValue{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroup().Schema, map[string]interface{}{
+		"project_id": testDataDeploymentGroupProjectID,
+		"name":       "web-servers",
+	})
+	diags := dataSourceDeploymentGroupRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Unable to find deployment group")
+}
+
+func TestDataSourceDeploymentGroup_Read_ErrorsWhenIDIsMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroup().Schema, map[string]interface{}{
+		"project_id":          testDataDeploymentGroupProjectID,
+		"deployment_group_id": 12,
+	})
+	diags := dataSourceDeploymentGroupRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "does not have an ID")
+	require.Equal(t, "", resourceData.Id())
+}
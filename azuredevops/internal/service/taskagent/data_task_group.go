@@ -0,0 +1,123 @@
+package taskagent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataTaskGroup schema and implementation for task group data source
+func DataTaskGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskGroupRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"major_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runs_on": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_name_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preview": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTaskGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+	majorVersion := d.Get("major_version").(int)
+
+	taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return diag.Errorf(" finding task group %s in project %s: %+v", name, projectID, err)
+	}
+
+	// the service returns every version of a task group, versions of the same task group share the ID
+	var versions []taskagent.TaskGroup
+	if taskGroups != nil {
+		for _, taskGroup := range *taskGroups {
+			if taskGroup.Id == nil || !strings.EqualFold(converter.ToString(taskGroup.Name, ""), name) {
+				continue
+			}
+			if len(versions) > 0 && *versions[0].Id != *taskGroup.Id {
+				return diag.Errorf(" Multiple task groups with name %s found in project %s", name, projectID)
+			}
+			versions = append(versions, taskGroup)
+		}
+	}
+
+	taskGroup := selectTaskGroupVersion(versions, majorVersion)
+	if taskGroup == nil {
+		if majorVersion > 0 {
+			return diag.Errorf(" Major version %d of task group %s does not exist in project %s", majorVersion, name, projectID)
+		}
+		return diag.Errorf(" Task group with name %s does not exist in project %s", name, projectID)
+	}
+
+	d.SetId(taskGroup.Id.String())
+	d.Set("description", converter.ToString(taskGroup.Description, ""))
+	d.Set("category", converter.ToString(taskGroup.Category, ""))
+	d.Set("instance_name_format", converter.ToString(taskGroup.InstanceNameFormat, ""))
+	d.Set("preview", converter.ToBool(taskGroup.Preview, false))
+	d.Set("revision", converter.ToInt(taskGroup.Revision, 0))
+	if taskGroup.RunsOn != nil {
+		d.Set("runs_on", *taskGroup.RunsOn)
+	}
+	if taskGroup.Version != nil {
+		d.Set("major_version", converter.ToInt(taskGroup.Version.Major, 0))
+		d.Set("version", flattenTaskGroupVersion(taskGroup.Version))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+//go:build (all || data_sources || data_task_group) && (!exclude_data_sources || !exclude_data_task_group)
+// +build all data_sources data_task_group
+// +build !exclude_data_sources !exclude_data_task_group
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataTaskGroupProjectID = uuid.New().String()
+
+func testDataTaskGroup(id uuid.UUID, name string, major int, preview bool) taskagent.TaskGroup {
+	return taskagent.TaskGroup{
+		Id:       &id,
+		Name:     converter.String(name),
+		Revision: converter.Int(1),
+		Preview:  converter.Bool(preview),
+		Version: &taskagent.TaskVersion{
+			Major: converter.Int(major),
+			Minor: converter.Int(2),
+			Patch: converter.Int(0),
+		},
+	}
+}
+
+func TestDataSourceTaskGroup_Read_PicksLatestStableVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskGroupID := uuid.New()
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project: converter.String(testDataTaskGroupProjectID),
+		}).
+		Return(&[]taskagent.TaskGroup{
+			testDataTaskGroup(uuid.New(), "other", 1, false),
+			testDataTaskGroup(taskGroupID, "Deploy-Web", 1, false),
+			testDataTaskGroup(taskGroupID, "Deploy-Web", 2, false),
+			testDataTaskGroup(taskGroupID, "Deploy-Web", 3, true),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTaskGroup().Schema, map[string]interface{}{
+		"project_id": testDataTaskGroupProjectID,
+		"name":       "deploy-web",
+	})
+	diags := dataSourceTaskGroupRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, taskGroupID.String(), resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("major_version"))
+	require.Equal(t, "2.2.0", resourceData.Get("version"))
+	require.False(t, resourceData.Get("preview").(bool))
+}
+
+func TestDataSourceTaskGroup_Read_ErrorsOnDuplicateNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskGroup{
+			testDataTaskGroup(uuid.New(), "deploy-web", 1, false),
+			testDataTaskGroup(uuid.New(), "deploy-web", 1, false),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTaskGroup().Schema, map[string]interface{}{
+		"project_id": testDataTaskGroupProjectID,
+		"name":       "deploy-web",
+	})
+	diags := dataSourceTaskGroupRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Multiple task groups")
+}
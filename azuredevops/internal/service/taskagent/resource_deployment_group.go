@@ -0,0 +1,173 @@
+package taskagent
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceDeploymentGroup schema and implementation for deployment group resource
+func ResourceDeploymentGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentGroupCreate,
+		ReadContext:   resourceDeploymentGroupRead,
+		UpdateContext: resourceDeploymentGroupUpdate,
+		DeleteContext: resourceDeploymentGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"pool_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"machine_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	parameter := &taskagent.DeploymentGroupCreateParameter{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+	}
+	// without a pool, Azure DevOps creates a new deployment pool for the deployment group
+	if v, ok := d.GetOk("pool_id"); ok {
+		parameter.PoolId = converter.Int(v.(int))
+	}
+
+	deploymentGroup, err := clients.TaskAgentClient.AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+		DeploymentGroup: parameter,
+		Project:         converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return diag.Errorf(" creating deployment group: %+v", err)
+	}
+	if deploymentGroup == nil || deploymentGroup.Id == nil {
+		return diag.Errorf(" creating deployment group: the service did not return the ID of the deployment group")
+	}
+
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	return resourceDeploymentGroupRead(ctx, d, m)
+}
+
+func resourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing deployment group ID: %+v", err)
+	}
+
+	deploymentGroup, err := clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" reading deployment group %s: %+v", d.Id(), err)
+	}
+	if deploymentGroup == nil || deploymentGroup.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenDeploymentGroup(d, deploymentGroup)
+	return nil
+}
+
+func resourceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing deployment group ID: %+v", err)
+	}
+
+	_, err = clients.TaskAgentClient.UpdateDeploymentGroup(clients.Ctx, taskagent.UpdateDeploymentGroupArgs{
+		DeploymentGroup: &taskagent.DeploymentGroupUpdateParameter{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		},
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupID,
+	})
+	if err != nil {
+		return diag.Errorf(" updating deployment group %s: %+v", d.Id(), err)
+	}
+	return resourceDeploymentGroupRead(ctx, d, m)
+}
+
+func resourceDeploymentGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing deployment group ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteDeploymentGroup(clients.Ctx, taskagent.DeleteDeploymentGroupArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupID,
+	})
+	if err != nil {
+		return diag.Errorf(" deleting deployment group %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenDeploymentGroup(d *schema.ResourceData, deploymentGroup *taskagent.DeploymentGroup) {
+	d.Set("name", converter.ToString(deploymentGroup.Name, ""))
+	d.Set("description", converter.ToString(deploymentGroup.Description, ""))
+	if deploymentGroup.Pool != nil {
+		d.Set("pool_id", converter.ToInt(deploymentGroup.Pool.Id, 0))
+		d.Set("pool_name", converter.ToString(deploymentGroup.Pool.Name, ""))
+	}
+	d.Set("machine_count", converter.ToInt(deploymentGroup.MachineCount, 0))
+}
@@ -0,0 +1,168 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceDeploymentGroupTargetTags schema and implementation for the tags of a deployment group target
+func ResourceDeploymentGroupTargetTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentGroupTargetTagsCreateOrUpdate,
+		ReadContext:   resourceDeploymentGroupTargetTagsRead,
+		UpdateContext: resourceDeploymentGroupTargetTagsCreateOrUpdate,
+		DeleteContext: resourceDeploymentGroupTargetTagsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeploymentGroupTargetTags,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"deployment_group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"target_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceDeploymentGroupTargetTagsCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	targetID := d.Get("target_id").(int)
+
+	tags := tfhelper.ExpandStringSet(d.Get("tags").(*schema.Set))
+	if err := updateDeploymentTargetTags(clients, d, tags); err != nil {
+		return diag.Errorf(" updating tags of deployment target %d: %+v", targetID, err)
+	}
+
+	d.SetId(deploymentGroupTargetTagsID(d.Get("deployment_group_id").(int), targetID))
+	return resourceDeploymentGroupTargetTagsRead(ctx, d, m)
+}
+
+func resourceDeploymentGroupTargetTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	target, err := clients.TaskAgentClient.GetDeploymentTarget(clients.Ctx, taskagent.GetDeploymentTargetArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: converter.Int(d.Get("deployment_group_id").(int)),
+		TargetId:          converter.Int(d.Get("target_id").(int)),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" reading deployment target %s: %+v", d.Id(), err)
+	}
+	if target == nil || target.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if target.Tags != nil {
+		d.Set("tags", *target.Tags)
+	} else {
+		d.Set("tags", nil)
+	}
+	return nil
+}
+
+func resourceDeploymentGroupTargetTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	if err := updateDeploymentTargetTags(clients, d, []string{}); err != nil {
+		return diag.Errorf(" removing tags of deployment target %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateDeploymentTargetTags replaces all tags of the deployment target
+func updateDeploymentTargetTags(clients *client.AggregatedClient, d *schema.ResourceData, tags []string) error {
+	_, err := clients.TaskAgentClient.UpdateDeploymentTargets(clients.Ctx, taskagent.UpdateDeploymentTargetsArgs{
+		Machines: &[]taskagent.DeploymentTargetUpdateParameter{{
+			Id:   converter.Int(d.Get("target_id").(int)),
+			Tags: &tags,
+		}},
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: converter.Int(d.Get("deployment_group_id").(int)),
+	})
+	return err
+}
+
+// importDeploymentGroupTargetTags imports the tags by an ID that looks like one of the following:
+//
+//	<project ID>/<deployment group ID>/<target ID>
+//	<project name>/<deployment group ID>/<target ID>
+func importDeploymentGroupTargetTags(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, fmt.Errorf(" unexpected format of ID (%s), expected <project>/<deployment group ID>/<target ID>", d.Id())
+	}
+
+	deploymentGroupID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf(" deployment group ID was expected to be integer, but was not: %+v", err)
+	}
+	targetID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf(" target ID was expected to be integer, but was not: %+v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("deployment_group_id", deploymentGroupID)
+	d.Set("target_id", targetID)
+	d.SetId(deploymentGroupTargetTagsID(deploymentGroupID, targetID))
+	return []*schema.ResourceData{d}, nil
+}
+
+// deploymentGroupTargetTagsID returns the ID of the tags, as target IDs are only unique within a deployment group
+func deploymentGroupTargetTagsID(deploymentGroupID, targetID int) string {
+	return fmt.Sprintf("%d/%d", deploymentGroupID, targetID)
+}
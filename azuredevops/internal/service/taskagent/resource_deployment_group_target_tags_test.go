@@ -0,0 +1,108 @@
+//go:build (all || resource_deployment_group_target_tags) && !exclude_resource_deployment_group_target_tags
+// +build all resource_deployment_group_target_tags
+// +build !exclude_resource_deployment_group_target_tags
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeploymentTargetProjectID = uuid.New().String()
+
+func TestDeploymentGroupTargetTags_Create_ReplacesTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		UpdateDeploymentTargets(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagent.UpdateDeploymentTargetsArgs) (*[]taskagent.DeploymentMachine, error) {
+			require.Equal(t, testDeploymentTargetProjectID, *args.Project)
+			require.Equal(t, 12, *args.DeploymentGroupId)
+			require.Len(t, *args.Machines, 1)
+			machine := (*args.Machines)[0]
+			require.Equal(t, 7, *machine.Id)
+			require.ElementsMatch(t, []string{"web", "prod"}, *machine.Tags)
+			return &[]taskagent.DeploymentMachine{}, nil
+		}).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentTarget(clients.Ctx, taskagent.GetDeploymentTargetArgs{
+			Project:           converter.String(testDeploymentTargetProjectID),
+			DeploymentGroupId: converter.Int(12),
+			TargetId:          converter.Int(7),
+		}).
+		Return(&taskagent.DeploymentMachine{Id: converter.Int(7), Tags: &[]string{"prod", "web"}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroupTargetTags().Schema, map[string]interface{}{
+		"project_id":          testDeploymentTargetProjectID,
+		"deployment_group_id": 12,
+		"target_id":           7,
+		"tags":                []interface{}{"web", "prod"},
+	})
+	diags := resourceDeploymentGroupTargetTagsCreateOrUpdate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "12/7", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("tags").(*schema.Set).Len())
+}
+
+func TestDeploymentGroupTargetTags_Delete_RemovesAllTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		UpdateDeploymentTargets(clients.Ctx, taskagent.UpdateDeploymentTargetsArgs{
+			Machines: &[]taskagent.DeploymentTargetUpdateParameter{{
+				Id:   converter.Int(7),
+				Tags: &[]string{},
+			}},
+			Project:           converter.String(testDeploymentTargetProjectID),
+			DeploymentGroupId: converter.Int(12),
+		}).
+		Return(&[]taskagent.DeploymentMachine{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroupTargetTags().Schema, map[string]interface{}{
+		"project_id":          testDeploymentTargetProjectID,
+		"deployment_group_id": 12,
+		"target_id":           7,
+		"tags":                []interface{}{"web"},
+	})
+	resourceData.SetId("12/7")
+	diags := resourceDeploymentGroupTargetTagsDelete(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestDeploymentGroupTargetTags_Import_SetsCompositeID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroupTargetTags().Schema, nil)
+	resourceData.SetId(testDeploymentTargetProjectID + "/12/7")
+
+	result, err := importDeploymentGroupTargetTags(context.Background(), resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "12/7", result[0].Id())
+	require.Equal(t, testDeploymentTargetProjectID, result[0].Get("project_id"))
+	require.Equal(t, 12, result[0].Get("deployment_group_id"))
+	require.Equal(t, 7, result[0].Get("target_id"))
+}
@@ -0,0 +1,152 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+// +build all resource_deployment_group
+// +build !exclude_resource_deployment_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeploymentGroupProjectID = uuid.New().String()
+
+var testDeploymentGroup = taskagent.DeploymentGroup{
+	Id:           converter.Int(12),
+	Name:         converter.String("web-servers"),
+	Description:  converter.String("IIS servers"),
+	MachineCount: converter.Int(2),
+	Pool: &taskagent.TaskAgentPoolReference{
+		Id:   converter.Int(34),
+		Name: converter.String("web-pool"),
+	},
+}
+
+func TestDeploymentGroup_Create_UsesExistingPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+			DeploymentGroup: &taskagent.DeploymentGroupCreateParameter{
+				Name:        converter.String("web-servers"),
+				Description: converter.String("IIS servers"),
+				PoolId:      converter.Int(34),
+			},
+			Project: converter.String(testDeploymentGroupProjectID),
+		}).
+		Return(&testDeploymentGroup, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+			Project:           converter.String(testDeploymentGroupProjectID),
+			DeploymentGroupId: converter.Int(12),
+		}).
+		Return(&testDeploymentGroup, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, map[string]interface{}{
+		"project_id":  testDeploymentGroupProjectID,
+		"name":        "web-servers",
+		"description": "IIS servers",
+		"pool_id":     34,
+	})
+	diags := resourceDeploymentGroupCreate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "12", resourceData.Id())
+	require.Equal(t, "web-pool", resourceData.Get("pool_name"))
+	require.Equal(t, 2, resourceData.Get("machine_count"))
+}
+
+func TestDeploymentGroup_Create_OmitsPoolWhenNotConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagent.AddDeploymentGroupArgs) (*taskagent.DeploymentGroup, error) {
+			require.Nil(t, args.DeploymentGroup.PoolId)
+			return nil, errors.New("AddDeploymentGroup() Failed")
+		}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, map[string]interface{}{
+		"project_id": testDeploymentGroupProjectID,
+		"name":       "web-servers",
+	})
+	diags := resourceDeploymentGroupCreate(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "AddDeploymentGroup() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestDeploymentGroup_Create_ErrorsWithoutDeploymentGroupID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, gomock.Any()).
+		Return(&taskagent.DeploymentGroup{Name: converter.String("web-servers")}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, map[string]interface{}{
+		"project_id": testDeploymentGroupProjectID,
+		"name":       "web-servers",
+	})
+	diags := resourceDeploymentGroupCreate(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "the service did not return the ID of the deployment group")
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestDeploymentGroup_Read_RemovesDeletedDeploymentGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, map[string]interface{}{
+		"project_id": testDeploymentGroupProjectID,
+		"name":       "web-servers",
+	})
+	resourceData.SetId("12")
+	diags := resourceDeploymentGroupRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,246 @@
+package taskagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/taskagentextras"
+)
+
+const sfResourceType = "securefile"
+
+// ResourceSecureFile schema and implementation for secure file resource
+func ResourceSecureFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecureFileCreate,
+		ReadContext:   resourceSecureFileRead,
+		UpdateContext: resourceSecureFileUpdate,
+		DeleteContext: resourceSecureFileDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"content_base64": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "The file's content encoded as base64, only a hash of the content is stored in the state",
+				ValidateFunc: validation.StringIsBase64,
+				StateFunc:    hashSecureFileContent,
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"authorize_all_pipelines": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceSecureFileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	content, err := base64.StdEncoding.DecodeString(d.Get("content_base64").(string))
+	if err != nil {
+		return diag.Errorf(" decoding the content of secure file: %+v", err)
+	}
+
+	secureFile, err := clients.TaskAgentClientExtras.UploadSecureFile(clients.Ctx, taskagentextras.UploadSecureFileArgs{
+		UploadStream: bytes.NewReader(content),
+		Project:      converter.String(projectID),
+		Name:         converter.String(d.Get("name").(string)),
+	})
+	if err != nil {
+		return diag.Errorf(" uploading secure file: %+v", err)
+	}
+	d.SetId(secureFile.Id.String())
+
+	// the properties can only be set once the file is uploaded
+	if properties := tfhelper.ExpandStringMap(d.Get("properties").(map[string]interface{})); len(*properties) > 0 {
+		secureFile.Properties = properties
+		_, err = clients.TaskAgentClientExtras.UpdateSecureFile(clients.Ctx, taskagentextras.UpdateSecureFileArgs{
+			SecureFile:   secureFile,
+			Project:      converter.String(projectID),
+			SecureFileId: secureFile.Id,
+		})
+		if err != nil {
+			return diag.Errorf(" setting properties of secure file %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.Get("authorize_all_pipelines").(bool) {
+		if err := updateSecureFileAuthorization(clients, d, projectID); err != nil {
+			return diag.Errorf(" authorizing secure file %s: %+v", d.Id(), err)
+		}
+	}
+	return resourceSecureFileRead(ctx, d, m)
+}
+
+func resourceSecureFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing secure file ID: %+v", err)
+	}
+
+	secureFile, err := clients.TaskAgentClientExtras.GetSecureFile(clients.Ctx, taskagentextras.GetSecureFileArgs{
+		Project:      converter.String(projectID),
+		SecureFileId: &secureFileID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" reading secure file %s: %+v", d.Id(), err)
+	}
+	if secureFile == nil || secureFile.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", converter.ToString(secureFile.Name, ""))
+	if secureFile.Properties != nil {
+		d.Set("properties", *secureFile.Properties)
+	} else {
+		d.Set("properties", nil)
+	}
+
+	projectResources, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: converter.String(projectID),
+		Type:    converter.String(sfResourceType),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return diag.Errorf(" reading authorization of secure file %s: %+v", d.Id(), err)
+	}
+
+	authorized := false
+	if projectResources != nil {
+		for _, resource := range *projectResources {
+			if resource.Id != nil && *resource.Id == d.Id() {
+				authorized = converter.ToBool(resource.Authorized, false)
+			}
+		}
+	}
+	d.Set("authorize_all_pipelines", authorized)
+	return nil
+}
+
+func resourceSecureFileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing secure file ID: %+v", err)
+	}
+
+	if d.HasChanges("name", "properties") {
+		_, err = clients.TaskAgentClientExtras.UpdateSecureFile(clients.Ctx, taskagentextras.UpdateSecureFileArgs{
+			SecureFile: &taskagent.SecureFile{
+				Id:         &secureFileID,
+				Name:       converter.String(d.Get("name").(string)),
+				Properties: tfhelper.ExpandStringMap(d.Get("properties").(map[string]interface{})),
+			},
+			Project:      converter.String(projectID),
+			SecureFileId: &secureFileID,
+		})
+		if err != nil {
+			return diag.Errorf(" updating secure file %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("authorize_all_pipelines") {
+		if err := updateSecureFileAuthorization(clients, d, projectID); err != nil {
+			return diag.Errorf(" authorizing secure file %s: %+v", d.Id(), err)
+		}
+	}
+	return resourceSecureFileRead(ctx, d, m)
+}
+
+func resourceSecureFileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing secure file ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClientExtras.DeleteSecureFile(clients.Ctx, taskagentextras.DeleteSecureFileArgs{
+		Project:      converter.String(d.Get("project_id").(string)),
+		SecureFileId: &secureFileID,
+	})
+	if err != nil {
+		return diag.Errorf(" deleting secure file %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateSecureFileAuthorization authorizes or unauthorizes the secure file for all pipelines of the project
+func updateSecureFileAuthorization(clients *client.AggregatedClient, d *schema.ResourceData, projectID string) error {
+	_, err := clients.BuildClient.AuthorizeProjectResources(clients.Ctx, build.AuthorizeProjectResourcesArgs{
+		Resources: &[]build.DefinitionResourceReference{{
+			Type:       converter.String(sfResourceType),
+			Id:         converter.String(d.Id()),
+			Name:       converter.String(d.Get("name").(string)),
+			Authorized: converter.Bool(d.Get("authorize_all_pipelines").(bool)),
+		}},
+		Project: converter.String(projectID),
+	})
+	return err
+}
+
+// hashSecureFileContent returns the SHA256 hash of the decoded base64 content, so the secret is not stored in the state.
+func hashSecureFileContent(v interface{}) string {
+	content, ok := v.(string)
+	if !ok || content == "" {
+		return ""
+	}
+	data, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		data = []byte(content)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,168 @@
+//go:build (all || resource_secure_file) && !exclude_resource_secure_file
+// +build all resource_secure_file
+// +build !exclude_resource_secure_file
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecureFileProjectID = uuid.New().String()
+var testSecureFileID = uuid.New()
+
+func TestSecureFile_HashSecureFileContent(t *testing.T) {
+	require.Equal(t, "", hashSecureFileContent(""))
+	// sha256 of "bar"
+	require.Equal(t, "fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9", hashSecureFileContent("YmFy"))
+}
+
+func TestSecureFile_Create_UploadsContentAndAuthorizes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClientExtras := azdosdkmocks.NewMockTaskagentextrasClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClientExtras: taskAgentClientExtras,
+		BuildClient:           buildClient,
+		Ctx:                   context.Background(),
+	}
+
+	properties := map[string]string{"team": "mobile"}
+	taskAgentClientExtras.
+		EXPECT().
+		UploadSecureFile(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagentextras.UploadSecureFileArgs) (*taskagent.SecureFile, error) {
+			require.Equal(t, testSecureFileProjectID, *args.Project)
+			require.Equal(t, "signing.p12", *args.Name)
+			content, err := io.ReadAll(args.UploadStream)
+			require.Nil(t, err)
+			require.Equal(t, "bar", string(content))
+			return &taskagent.SecureFile{Id: &testSecureFileID, Name: args.Name}, nil
+		}).
+		Times(1)
+	taskAgentClientExtras.
+		EXPECT().
+		UpdateSecureFile(clients.Ctx, taskagentextras.UpdateSecureFileArgs{
+			SecureFile: &taskagent.SecureFile{
+				Id:         &testSecureFileID,
+				Name:       converter.String("signing.p12"),
+				Properties: &properties,
+			},
+			Project:      converter.String(testSecureFileProjectID),
+			SecureFileId: &testSecureFileID,
+		}).
+		Return(&taskagent.SecureFile{}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		AuthorizeProjectResources(clients.Ctx, build.AuthorizeProjectResourcesArgs{
+			Resources: &[]build.DefinitionResourceReference{{
+				Type:       converter.String("securefile"),
+				Id:         converter.String(testSecureFileID.String()),
+				Name:       converter.String("signing.p12"),
+				Authorized: converter.Bool(true),
+			}},
+			Project: converter.String(testSecureFileProjectID),
+		}).
+		Return(nil, nil).
+		Times(1)
+	taskAgentClientExtras.
+		EXPECT().
+		GetSecureFile(clients.Ctx, taskagentextras.GetSecureFileArgs{
+			Project:      converter.String(testSecureFileProjectID),
+			SecureFileId: &testSecureFileID,
+		}).
+		Return(&taskagent.SecureFile{
+			Id:         &testSecureFileID,
+			Name:       converter.String("signing.p12"),
+			Properties: &properties,
+		}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.Ctx, gomock.Any()).
+		Return(&[]build.DefinitionResourceReference{{
+			Id:         converter.String(testSecureFileID.String()),
+			Authorized: converter.Bool(true),
+		}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, map[string]interface{}{
+		"project_id":              testSecureFileProjectID,
+		"name":                    "signing.p12",
+		"content_base64":          "YmFy",
+		"properties":              map[string]interface{}{"team": "mobile"},
+		"authorize_all_pipelines": true,
+	})
+	diags := resourceSecureFileCreate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, testSecureFileID.String(), resourceData.Id())
+	require.True(t, resourceData.Get("authorize_all_pipelines").(bool))
+	require.Equal(t, "mobile", resourceData.Get("properties.team"))
+}
+
+func TestSecureFile_Create_DoesNotSwallowUploadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClientExtras := azdosdkmocks.NewMockTaskagentextrasClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	taskAgentClientExtras.
+		EXPECT().
+		UploadSecureFile(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UploadSecureFile() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, map[string]interface{}{
+		"project_id":     testSecureFileProjectID,
+		"name":           "signing.p12",
+		"content_base64": "YmFy",
+	})
+	diags := resourceSecureFileCreate(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "UploadSecureFile() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestSecureFile_Read_RemovesDeletedSecureFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClientExtras := azdosdkmocks.NewMockTaskagentextrasClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	taskAgentClientExtras.
+		EXPECT().
+		GetSecureFile(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, map[string]interface{}{
+		"project_id":     testSecureFileProjectID,
+		"name":           "signing.p12",
+		"content_base64": "YmFy",
+	})
+	resourceData.SetId(testSecureFileID.String())
+	diags := resourceSecureFileRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
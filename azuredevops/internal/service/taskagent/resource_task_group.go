@@ -0,0 +1,659 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/taskagentextras"
+)
+
+const (
+	tgStepDefinitionTypeTask      = "task"
+	tgStepDefinitionTypeTaskGroup = "metaTask"
+)
+
+// ResourceTaskGroup schema and implementation for task group resource
+func ResourceTaskGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTaskGroupCreate,
+		ReadContext:   resourceTaskGroupRead,
+		UpdateContext: resourceTaskGroupUpdate,
+		DeleteContext: resourceTaskGroupDelete,
+		CustomizeDiff: customizeDiffTaskGroupVersion,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Deploy",
+				ValidateFunc: validation.StringInSlice([]string{"Build", "Deploy", "Package", "Test", "Utility"}, false),
+			},
+			"runs_on": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Agent", "DeploymentGroup", "Server"}, false),
+				},
+			},
+			"instance_name_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"major_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"disable_prior_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "string",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"help_markdown": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"visible_rule": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"options": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"step": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"definition_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  tgStepDefinitionTypeTask,
+							ValidateFunc: validation.StringInSlice([]string{
+								tgStepDefinitionTypeTask,
+								tgStepDefinitionTypeTaskGroup,
+							}, false),
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"continue_on_error": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"always_run": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"condition": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "succeeded()",
+						},
+						"timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"retry_count_on_task_failure": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"inputs": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffTaskGroupVersion validates the version changes which can be applied to a task group. A task group
+// is created as a stable version, every new major version is published from a draft of the current major version.
+func customizeDiffTaskGroupVersion(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	preview := d.Get("preview").(bool)
+	if d.Id() == "" {
+		if preview {
+			return fmt.Errorf(" a new task group cannot be created as preview, only new major versions can be published as preview")
+		}
+		return nil
+	}
+
+	oldMajor, newMajor := d.GetChange("major_version")
+	switch {
+	case newMajor.(int) < oldMajor.(int):
+		return fmt.Errorf(" major_version cannot be decreased from %d to %d", oldMajor, newMajor)
+	case newMajor.(int) > oldMajor.(int)+1:
+		return fmt.Errorf(" major_version can only be increased by one, from %d to %d", oldMajor, oldMajor.(int)+1)
+	case newMajor.(int) == oldMajor.(int):
+		oldPreview, _ := d.GetChange("preview")
+		if preview && !oldPreview.(bool) {
+			return fmt.Errorf(" major version %d is already published, only a new major version can be published as preview", oldMajor)
+		}
+	}
+	return nil
+}
+
+func resourceTaskGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	createParameter, err := expandTaskGroupCreateParameter(d)
+	if err != nil {
+		return diag.Errorf(" creating task group: %+v", err)
+	}
+	createParameter.Version = &taskagent.TaskVersion{
+		Major:  converter.Int(1),
+		Minor:  converter.Int(0),
+		Patch:  converter.Int(0),
+		IsTest: converter.Bool(false),
+	}
+	if v, ok := d.GetOk("major_version"); ok {
+		createParameter.Version.Major = converter.Int(v.(int))
+	}
+
+	taskGroup, err := clients.TaskAgentClient.AddTaskGroup(clients.Ctx, taskagent.AddTaskGroupArgs{
+		TaskGroup: createParameter,
+		Project:   converter.String(projectID),
+	})
+	if err != nil {
+		return diag.Errorf(" creating task group: %+v", err)
+	}
+
+	d.SetId(taskGroup.Id.String())
+	d.Set("major_version", converter.ToInt(taskGroup.Version.Major, 1))
+	return resourceTaskGroupRead(ctx, d, m)
+}
+
+func resourceTaskGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing task group ID: %+v", err)
+	}
+
+	taskGroup, err := getTaskGroupVersion(clients, projectID, taskGroupID, d.Get("major_version").(int))
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" reading task group %s: %+v", d.Id(), err)
+	}
+	if taskGroup == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenTaskGroup(d, taskGroup)
+	return nil
+}
+
+func resourceTaskGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing task group ID: %+v", err)
+	}
+
+	oldMajor, newMajor := d.GetChange("major_version")
+	current, err := getTaskGroupVersion(clients, projectID, taskGroupID, oldMajor.(int))
+	if err != nil {
+		return diag.Errorf(" reading task group %s: %+v", d.Id(), err)
+	}
+	if current == nil {
+		return diag.Errorf(" major version %d of task group %s does not exist", oldMajor, d.Id())
+	}
+
+	if newMajor.(int) > oldMajor.(int) {
+		if err := publishTaskGroupMajorVersion(clients, d, projectID, current); err != nil {
+			return diag.Errorf(" publishing major version %d of task group %s: %+v", newMajor, d.Id(), err)
+		}
+		return resourceTaskGroupRead(ctx, d, m)
+	}
+
+	if d.HasChangesExcept("preview", "disable_prior_versions") {
+		updateParameter, err := expandTaskGroupUpdateParameter(d, current)
+		if err != nil {
+			return diag.Errorf(" updating task group %s: %+v", d.Id(), err)
+		}
+
+		current, err = clients.TaskAgentClient.UpdateTaskGroup(clients.Ctx, taskagent.UpdateTaskGroupArgs{
+			TaskGroup:   updateParameter,
+			Project:     converter.String(projectID),
+			TaskGroupId: &taskGroupID,
+		})
+		if err != nil {
+			return diag.Errorf(" updating task group %s: %+v", d.Id(), err)
+		}
+	}
+
+	if converter.ToBool(current.Preview, false) && !d.Get("preview").(bool) {
+		current.Preview = converter.Bool(false)
+		_, err = clients.TaskAgentClientExtras.PublishPreviewTaskGroup(clients.Ctx, taskagentextras.PublishPreviewTaskGroupArgs{
+			TaskGroup:            current,
+			Project:              converter.String(projectID),
+			TaskGroupId:          &taskGroupID,
+			DisablePriorVersions: converter.Bool(d.Get("disable_prior_versions").(bool)),
+		})
+		if err != nil {
+			return diag.Errorf(" publishing preview of task group %s: %+v", d.Id(), err)
+		}
+	}
+
+	return resourceTaskGroupRead(ctx, d, m)
+}
+
+func resourceTaskGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" parsing task group ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteTaskGroup(clients.Ctx, taskagent.DeleteTaskGroupArgs{
+		Project:     converter.String(d.Get("project_id").(string)),
+		TaskGroupId: &taskGroupID,
+	})
+	if err != nil {
+		return diag.Errorf(" deleting task group %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// publishTaskGroupMajorVersion creates a draft of the task group from the configuration and publishes it as the next
+// major version, which is how the service versions task groups.
+func publishTaskGroupMajorVersion(clients *client.AggregatedClient, d *schema.ResourceData, projectID string, current *taskagent.TaskGroup) error {
+	draftParameter, err := expandTaskGroupCreateParameter(d)
+	if err != nil {
+		return err
+	}
+	draftParameter.ParentDefinitionId = current.Id
+	draftParameter.Version = current.Version
+
+	draft, err := clients.TaskAgentClient.AddTaskGroup(clients.Ctx, taskagent.AddTaskGroupArgs{
+		TaskGroup: draftParameter,
+		Project:   converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating draft: %+v", err)
+	}
+
+	_, err = clients.TaskAgentClientExtras.PublishTaskGroup(clients.Ctx, taskagentextras.PublishTaskGroupArgs{
+		TaskGroupMetadata: &taskagent.PublishTaskGroupMetadata{
+			ParentDefinitionRevision: current.Revision,
+			Preview:                  converter.Bool(d.Get("preview").(bool)),
+			TaskGroupId:              draft.Id,
+			TaskGroupRevision:        draft.Revision,
+		},
+		Project:           converter.String(projectID),
+		ParentTaskGroupId: current.Id,
+	})
+	return err
+}
+
+// getTaskGroupVersion returns the given major version of a task group, or the latest version if no major version is
+// given. The result is nil if the version does not exist or has been deleted.
+func getTaskGroupVersion(clients *client.AggregatedClient, projectID string, taskGroupID uuid.UUID, majorVersion int) (*taskagent.TaskGroup, error) {
+	taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+		Project:     converter.String(projectID),
+		TaskGroupId: &taskGroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if taskGroups == nil {
+		return nil, nil
+	}
+	return selectTaskGroupVersion(*taskGroups, majorVersion), nil
+}
+
+// selectTaskGroupVersion picks the given major version from the versions of a task group. Without a major version the
+// latest stable version is picked, or the latest preview if no stable version exists.
+func selectTaskGroupVersion(taskGroups []taskagent.TaskGroup, majorVersion int) *taskagent.TaskGroup {
+	var latest, latestPreview *taskagent.TaskGroup
+	for i := range taskGroups {
+		taskGroup := &taskGroups[i]
+		if converter.ToBool(taskGroup.Deleted, false) || taskGroup.Version == nil {
+			continue
+		}
+		major := converter.ToInt(taskGroup.Version.Major, 0)
+		if majorVersion > 0 {
+			if major == majorVersion {
+				return taskGroup
+			}
+			continue
+		}
+		if converter.ToBool(taskGroup.Preview, false) {
+			if latestPreview == nil || major > converter.ToInt(latestPreview.Version.Major, 0) {
+				latestPreview = taskGroup
+			}
+		} else if latest == nil || major > converter.ToInt(latest.Version.Major, 0) {
+			latest = taskGroup
+		}
+	}
+	if latest == nil {
+		return latestPreview
+	}
+	return latest
+}
+
+func expandTaskGroupCreateParameter(d *schema.ResourceData) (*taskagent.TaskGroupCreateParameter, error) {
+	steps, err := expandTaskGroupSteps(d.Get("step").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	return &taskagent.TaskGroupCreateParameter{
+		Name:               converter.String(name),
+		FriendlyName:       converter.String(name),
+		Description:        converter.String(d.Get("description").(string)),
+		Category:           converter.String(d.Get("category").(string)),
+		InstanceNameFormat: converter.String(expandTaskGroupInstanceNameFormat(d)),
+		RunsOn:             expandTaskGroupRunsOn(d),
+		Inputs:             expandTaskGroupInputs(d.Get("input").([]interface{})),
+		Tasks:              steps,
+	}, nil
+}
+
+func expandTaskGroupUpdateParameter(d *schema.ResourceData, current *taskagent.TaskGroup) (*taskagent.TaskGroupUpdateParameter, error) {
+	createParameter, err := expandTaskGroupCreateParameter(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskagent.TaskGroupUpdateParameter{
+		Id:                 current.Id,
+		Revision:           current.Revision,
+		Version:            current.Version,
+		Name:               createParameter.Name,
+		FriendlyName:       createParameter.FriendlyName,
+		Description:        createParameter.Description,
+		Category:           createParameter.Category,
+		InstanceNameFormat: createParameter.InstanceNameFormat,
+		RunsOn:             createParameter.RunsOn,
+		Inputs:             createParameter.Inputs,
+		Tasks:              createParameter.Tasks,
+	}, nil
+}
+
+func expandTaskGroupInstanceNameFormat(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("instance_name_format"); ok {
+		return v.(string)
+	}
+	return fmt.Sprintf("Task group: %s", d.Get("name").(string))
+}
+
+func expandTaskGroupRunsOn(d *schema.ResourceData) *[]string {
+	if v, ok := d.GetOk("runs_on"); ok {
+		runsOn := tfhelper.ExpandStringSet(v.(*schema.Set))
+		return &runsOn
+	}
+	return &[]string{"Agent", "DeploymentGroup"}
+}
+
+func expandTaskGroupInputs(inputs []interface{}) *[]taskagent.TaskInputDefinition {
+	result := make([]taskagent.TaskInputDefinition, 0, len(inputs))
+	for _, raw := range inputs {
+		input := raw.(map[string]interface{})
+		name := input["name"].(string)
+		label := input["label"].(string)
+		if label == "" {
+			label = name
+		}
+		result = append(result, taskagent.TaskInputDefinition{
+			Name:         converter.String(name),
+			Label:        converter.String(label),
+			Type:         converter.String(input["type"].(string)),
+			DefaultValue: converter.String(input["default_value"].(string)),
+			Required:     converter.Bool(input["required"].(bool)),
+			HelpMarkDown: converter.String(input["help_markdown"].(string)),
+			GroupName:    converter.String(input["group_name"].(string)),
+			VisibleRule:  converter.String(input["visible_rule"].(string)),
+			Options:      tfhelper.ExpandStringMap(input["options"].(map[string]interface{})),
+		})
+	}
+	return &result
+}
+
+func expandTaskGroupSteps(steps []interface{}) (*[]taskagent.TaskGroupStep, error) {
+	result := make([]taskagent.TaskGroupStep, 0, len(steps))
+	for _, raw := range steps {
+		step := raw.(map[string]interface{})
+		taskID, err := uuid.Parse(step["task_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf(" parsing task ID: %+v", err)
+		}
+		result = append(result, taskagent.TaskGroupStep{
+			Task: &taskagent.TaskDefinitionReference{
+				Id:             &taskID,
+				VersionSpec:    converter.String(step["version"].(string)),
+				DefinitionType: converter.String(step["definition_type"].(string)),
+			},
+			DisplayName:             converter.String(step["display_name"].(string)),
+			Enabled:                 converter.Bool(step["enabled"].(bool)),
+			ContinueOnError:         converter.Bool(step["continue_on_error"].(bool)),
+			AlwaysRun:               converter.Bool(step["always_run"].(bool)),
+			Condition:               converter.String(step["condition"].(string)),
+			TimeoutInMinutes:        converter.Int(step["timeout_in_minutes"].(int)),
+			RetryCountOnTaskFailure: converter.Int(step["retry_count_on_task_failure"].(int)),
+			Inputs:                  tfhelper.ExpandStringMap(step["inputs"].(map[string]interface{})),
+			Environment:             tfhelper.ExpandStringMap(step["environment"].(map[string]interface{})),
+		})
+	}
+	return &result, nil
+}
+
+func flattenTaskGroup(d *schema.ResourceData, taskGroup *taskagent.TaskGroup) {
+	d.Set("name", converter.ToString(taskGroup.Name, ""))
+	d.Set("description", converter.ToString(taskGroup.Description, ""))
+	d.Set("category", converter.ToString(taskGroup.Category, ""))
+	d.Set("instance_name_format", converter.ToString(taskGroup.InstanceNameFormat, ""))
+	d.Set("preview", converter.ToBool(taskGroup.Preview, false))
+	d.Set("revision", converter.ToInt(taskGroup.Revision, 0))
+	if taskGroup.RunsOn != nil {
+		d.Set("runs_on", *taskGroup.RunsOn)
+	}
+	if taskGroup.Version != nil {
+		d.Set("major_version", converter.ToInt(taskGroup.Version.Major, 0))
+		d.Set("version", flattenTaskGroupVersion(taskGroup.Version))
+	}
+	d.Set("input", flattenTaskGroupInputs(taskGroup.Inputs))
+	steps := flattenTaskGroupSteps(taskGroup.Tasks)
+	// the service adds the default values of all task inputs, only the configured inputs are kept
+	tfhelper.RemoveUnconfiguredMapKeys(steps, d.Get("step"), "inputs")
+	d.Set("step", steps)
+}
+
+func flattenTaskGroupVersion(version *taskagent.TaskVersion) string {
+	return fmt.Sprintf("%d.%d.%d", converter.ToInt(version.Major, 0), converter.ToInt(version.Minor, 0), converter.ToInt(version.Patch, 0))
+}
+
+func flattenTaskGroupInputs(inputs *[]taskagent.TaskInputDefinition) []interface{} {
+	if inputs == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*inputs))
+	for _, input := range *inputs {
+		flattened := map[string]interface{}{
+			"name":          converter.ToString(input.Name, ""),
+			"label":         converter.ToString(input.Label, ""),
+			"type":          converter.ToString(input.Type, ""),
+			"default_value": converter.ToString(input.DefaultValue, ""),
+			"required":      converter.ToBool(input.Required, false),
+			"help_markdown": converter.ToString(input.HelpMarkDown, ""),
+			"group_name":    converter.ToString(input.GroupName, ""),
+			"visible_rule":  converter.ToString(input.VisibleRule, ""),
+		}
+		if input.Options != nil {
+			flattened["options"] = *input.Options
+		}
+		result = append(result, flattened)
+	}
+	return result
+}
+
+func flattenTaskGroupSteps(steps *[]taskagent.TaskGroupStep) []interface{} {
+	if steps == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(*steps))
+	for _, step := range *steps {
+		flattened := map[string]interface{}{
+			"display_name":                converter.ToString(step.DisplayName, ""),
+			"enabled":                     converter.ToBool(step.Enabled, true),
+			"continue_on_error":           converter.ToBool(step.ContinueOnError, false),
+			"always_run":                  converter.ToBool(step.AlwaysRun, false),
+			"condition":                   converter.ToString(step.Condition, ""),
+			"timeout_in_minutes":          converter.ToInt(step.TimeoutInMinutes, 0),
+			"retry_count_on_task_failure": converter.ToInt(step.RetryCountOnTaskFailure, 0),
+		}
+		if step.Task != nil {
+			if step.Task.Id != nil {
+				flattened["task_id"] = step.Task.Id.String()
+			}
+			flattened["version"] = converter.ToString(step.Task.VersionSpec, "")
+			flattened["definition_type"] = converter.ToString(step.Task.DefinitionType, tgStepDefinitionTypeTask)
+		}
+		if step.Inputs != nil {
+			flattened["inputs"] = *step.Inputs
+		}
+		if step.Environment != nil {
+			flattened["environment"] = *step.Environment
+		}
+		result = append(result, flattened)
+	}
+	return result
+}
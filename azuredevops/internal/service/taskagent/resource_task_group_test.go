@@ -0,0 +1,208 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+// +build all resource_task_group
+// +build !exclude_resource_task_group
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testTaskGroupProjectID = uuid.New().String()
+var testTaskGroupID = uuid.New()
+
+var testTaskGroupConfiguration = map[string]interface{}{
+	"project_id": testTaskGroupProjectID,
+	"name":       "deploy-web",
+	"input": []interface{}{
+		map[string]interface{}{
+			"name":          "environment",
+			"default_value": "dev",
+			"required":      true,
+		},
+	},
+	"step": []interface{}{
+		map[string]interface{}{
+			"task_id": "d9bafed4-0b18-4f58-968d-86655b4d2ce9",
+			"version": "2.*",
+			"inputs": map[string]interface{}{
+				"script": "echo $(environment)",
+			},
+		},
+	},
+}
+
+func testTaskGroupVersion(major int, preview bool, deleted bool) taskagent.TaskGroup {
+	return taskagent.TaskGroup{
+		Id:       &testTaskGroupID,
+		Name:     converter.String("deploy-web"),
+		Revision: converter.Int(major),
+		Preview:  converter.Bool(preview),
+		Deleted:  converter.Bool(deleted),
+		Version: &taskagent.TaskVersion{
+			Major: converter.Int(major),
+			Minor: converter.Int(0),
+			Patch: converter.Int(0),
+		},
+	}
+}
+
+func TestTaskGroup_SelectTaskGroupVersion(t *testing.T) {
+	versions := []taskagent.TaskGroup{
+		testTaskGroupVersion(1, false, false),
+		testTaskGroupVersion(2, false, false),
+		testTaskGroupVersion(3, true, false),
+		testTaskGroupVersion(4, false, true),
+	}
+
+	require.Equal(t, 2, *selectTaskGroupVersion(versions, 0).Version.Major)
+	require.Equal(t, 1, *selectTaskGroupVersion(versions, 1).Version.Major)
+	require.Equal(t, 3, *selectTaskGroupVersion(versions, 3).Version.Major)
+	require.Nil(t, selectTaskGroupVersion(versions, 4))
+	require.Equal(t, 3, *selectTaskGroupVersion(versions[2:], 0).Version.Major)
+}
+
+func TestTaskGroup_Create_SendsConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	created := testTaskGroupVersion(1, false, false)
+	taskAgentClient.
+		EXPECT().
+		AddTaskGroup(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagent.AddTaskGroupArgs) (*taskagent.TaskGroup, error) {
+			require.Equal(t, testTaskGroupProjectID, *args.Project)
+			require.Equal(t, "Deploy", *args.TaskGroup.Category)
+			require.Equal(t, "Task group: deploy-web", *args.TaskGroup.InstanceNameFormat)
+			require.Equal(t, []string{"Agent", "DeploymentGroup"}, *args.TaskGroup.RunsOn)
+			require.Equal(t, 1, *args.TaskGroup.Version.Major)
+			require.Nil(t, args.TaskGroup.ParentDefinitionId)
+
+			inputs := *args.TaskGroup.Inputs
+			require.Len(t, inputs, 1)
+			require.Equal(t, "environment", *inputs[0].Label)
+			require.Equal(t, "string", *inputs[0].Type)
+			require.True(t, *inputs[0].Required)
+
+			steps := *args.TaskGroup.Tasks
+			require.Len(t, steps, 1)
+			require.Equal(t, "2.*", *steps[0].Task.VersionSpec)
+			require.Equal(t, tgStepDefinitionTypeTask, *steps[0].Task.DefinitionType)
+			require.Equal(t, "echo $(environment)", (*steps[0].Inputs)["script"])
+			return &created, nil
+		}).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project:     converter.String(testTaskGroupProjectID),
+			TaskGroupId: &testTaskGroupID,
+		}).
+		Return(&[]taskagent.TaskGroup{created}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, testTaskGroupConfiguration)
+	diags := resourceTaskGroupCreate(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, testTaskGroupID.String(), resourceData.Id())
+	require.Equal(t, 1, resourceData.Get("major_version"))
+	require.Equal(t, "1.0.0", resourceData.Get("version"))
+}
+
+func TestTaskGroup_Flatten_KeepsConfiguredInputs(t *testing.T) {
+	taskID := uuid.MustParse("d9bafed4-0b18-4f58-968d-86655b4d2ce9")
+	taskGroup := testTaskGroupVersion(1, false, false)
+	taskGroup.Tasks = &[]taskagent.TaskGroupStep{{
+		Task: &taskagent.TaskDefinitionReference{
+			Id:          &taskID,
+			VersionSpec: converter.String("2.*"),
+		},
+		// the service adds the default values of all inputs of a task
+		Inputs: &map[string]string{"script": "echo $(environment)", "workingDirectory": ""},
+	}}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, testTaskGroupConfiguration)
+	flattenTaskGroup(resourceData, &taskGroup)
+	require.Equal(t, map[string]interface{}{"script": "echo $(environment)"}, resourceData.Get("step.0.inputs"))
+}
+
+func TestTaskGroup_Read_RemovesDeletedTaskGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskGroup{testTaskGroupVersion(1, false, true)}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, testTaskGroupConfiguration)
+	resourceData.SetId(testTaskGroupID.String())
+	diags := resourceTaskGroupRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestTaskGroup_PublishMajorVersion_PublishesDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	taskAgentClientExtras := azdosdkmocks.NewMockTaskagentextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: taskAgentClientExtras,
+		Ctx:                   context.Background(),
+	}
+
+	current := testTaskGroupVersion(1, false, false)
+	draftID := uuid.New()
+	taskAgentClient.
+		EXPECT().
+		AddTaskGroup(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagent.AddTaskGroupArgs) (*taskagent.TaskGroup, error) {
+			require.Equal(t, testTaskGroupID, *args.TaskGroup.ParentDefinitionId)
+			return &taskagent.TaskGroup{Id: &draftID, Revision: converter.Int(1)}, nil
+		}).
+		Times(1)
+	taskAgentClientExtras.
+		EXPECT().
+		PublishTaskGroup(clients.Ctx, taskagentextras.PublishTaskGroupArgs{
+			TaskGroupMetadata: &taskagent.PublishTaskGroupMetadata{
+				ParentDefinitionRevision: current.Revision,
+				Preview:                  converter.Bool(true),
+				TaskGroupId:              &draftID,
+				TaskGroupRevision:        converter.Int(1),
+			},
+			Project:           converter.String(testTaskGroupProjectID),
+			ParentTaskGroupId: &testTaskGroupID,
+		}).
+		Return(&[]taskagent.TaskGroup{}, nil).
+		Times(1)
+
+	configuration := map[string]interface{}{}
+	for key, value := range testTaskGroupConfiguration {
+		configuration[key] = value
+	}
+	configuration["preview"] = true
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, configuration)
+	err := publishTaskGroupMajorVersion(clients, resourceData, testTaskGroupProjectID, &current)
+	require.Nil(t, err)
+}
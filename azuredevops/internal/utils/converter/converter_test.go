@@ -26,6 +26,15 @@ func TestInt(t *testing.T) {
 	}
 }
 
+func TestToInt(t *testing.T) {
+	if ToInt(Int(42), 1) != 42 {
+		t.Errorf("The value of the pointer was not returned")
+	}
+	if ToInt(nil, 1) != 1 {
+		t.Errorf("The default value was not returned for a nil pointer")
+	}
+}
+
 func TestBoolTrue(t *testing.T) {
 	value := true
 	valuePtr := Bool(value)
@@ -37,6 +37,10 @@ func ParseGitRepoBranchID(id string) (string, string, error) {
 	return parseTwoPartID(id, ":", "repositoryID:branchName")
 }
 
+func ParseGitRepoTagID(id string) (string, string, error) {
+	return parseTwoPartID(id, ":", "repositoryID:tagName")
+}
+
 func parseTwoPartID(id, sep, want string) (string, string, error) {
 	parts := strings.SplitN(id, sep, 2)
 	if len(parts) != 2 || strings.EqualFold(parts[0], "") || strings.EqualFold(parts[1], "") {
@@ -102,6 +106,15 @@ func ExpandStringSet(d *schema.Set) []string {
 	return ExpandStringList(d.List())
 }
 
+// ExpandStringMap expand a map of interface into a map of string
+func ExpandStringMap(d map[string]interface{}) *map[string]string {
+	vs := make(map[string]string, len(d))
+	for k, v := range d {
+		vs[k] = v.(string)
+	}
+	return &vs
+}
+
 // ImportProjectQualifiedResource Import a resource by an ID that looks like one of the following:
 //
 //	<project ID>/<resource ID>
@@ -205,3 +218,52 @@ func FindMapInSetWithGivenKeyValue(d *schema.ResourceData, setName string, keyNa
 	}
 	return nil
 }
+
+// RemoveUnconfiguredMapKeys removes the keys of the maps stored as `mapKey` in the flattened value, which are not part
+// of the same map in the configured value, e.g. the default values of task inputs added by the service. The configured
+// value is the value of the same attribute in the resource data, list elements are matched by their index. Elements
+// without a configured counterpart, e.g. after an import, are kept as they are.
+func RemoveUnconfiguredMapKeys(flattened interface{}, configured interface{}, mapKey string) {
+	switch value := flattened.(type) {
+	case []interface{}:
+		configuredList, _ := configured.([]interface{})
+		for i, element := range value {
+			if i < len(configuredList) {
+				RemoveUnconfiguredMapKeys(element, configuredList[i], mapKey)
+			}
+		}
+	case map[string]interface{}:
+		configuredMap, ok := configured.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, element := range value {
+			if key != mapKey {
+				RemoveUnconfiguredMapKeys(element, configuredMap[key], mapKey)
+				continue
+			}
+			if configuredKeys, ok := configuredMap[key].(map[string]interface{}); ok {
+				value[key] = filterMapKeys(element, configuredKeys)
+			}
+		}
+	}
+}
+
+func filterMapKeys(m interface{}, keys map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	switch values := m.(type) {
+	case map[string]interface{}:
+		for key, value := range values {
+			if _, ok := keys[key]; ok {
+				result[key] = value
+			}
+		}
+	case map[string]string:
+		for key, value := range values {
+			if _, ok := keys[key]; ok {
+				result[key] = value
+			}
+		}
+	}
+	return result
+}
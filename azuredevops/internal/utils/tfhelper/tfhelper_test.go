@@ -91,3 +91,38 @@ func TestGetRealProjectId(t *testing.T) {
 		require.Equal(t, tc.exceptProjectID, projectID)
 	}
 }
+
+func TestExpandStringMap(t *testing.T) {
+	require.Equal(t, map[string]string{"a": "1", "b": ""}, *ExpandStringMap(map[string]interface{}{"a": "1", "b": ""}))
+	require.Empty(t, *ExpandStringMap(nil))
+}
+
+func TestRemoveUnconfiguredMapKeys(t *testing.T) {
+	flattened := []interface{}{
+		map[string]interface{}{
+			"name": "first",
+			"step": []interface{}{
+				map[string]interface{}{"inputs": map[string]string{"script": "echo", "workingDirectory": ""}},
+			},
+		},
+		map[string]interface{}{
+			"name": "imported",
+			"step": []interface{}{
+				map[string]interface{}{"inputs": map[string]interface{}{"script": "ls", "failOnStderr": "false"}},
+			},
+		},
+	}
+	configured := []interface{}{
+		map[string]interface{}{
+			"name": "first",
+			"step": []interface{}{
+				map[string]interface{}{"inputs": map[string]interface{}{"script": "echo"}},
+			},
+		},
+	}
+
+	RemoveUnconfiguredMapKeys(flattened, configured, "inputs")
+
+	require.Equal(t, map[string]interface{}{"script": "echo"}, flattened[0].(map[string]interface{})["step"].([]interface{})[0].(map[string]interface{})["inputs"])
+	require.Equal(t, map[string]interface{}{"script": "ls", "failOnStderr": "false"}, flattened[1].(map[string]interface{})["step"].([]interface{})[0].(map[string]interface{})["inputs"])
+}
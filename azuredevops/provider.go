@@ -16,9 +16,11 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/graph"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/identity"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/notification"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/policy/branch"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/policy/repository"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/release"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/securityroles"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/servicehook"
@@ -34,6 +36,7 @@ func Provider() *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"azuredevops_resource_authorization":                 build.ResourceResourceAuthorization(),
 			"azuredevops_pipeline_authorization":                 build.ResourcePipelineAuthorization(),
+			"azuredevops_pipeline_run":                           build.ResourcePipelineRun(),
 			"azuredevops_branch_policy_build_validation":         branch.ResourceBranchPolicyBuildValidation(),
 			"azuredevops_branch_policy_min_reviewers":            branch.ResourceBranchPolicyMinReviewers(),
 			"azuredevops_branch_policy_auto_reviewers":           branch.ResourceBranchPolicyAutoReviewers(),
@@ -42,11 +45,17 @@ func Provider() *schema.Provider {
 			"azuredevops_branch_policy_merge_types":              branch.ResourceBranchPolicyMergeTypes(),
 			"azuredevops_branch_policy_status_check":             branch.ResourceBranchPolicyStatusCheck(),
 			"azuredevops_build_definition":                       build.ResourceBuildDefinition(),
+			"azuredevops_release_definition":                     release.ResourceReleaseDefinition(),
 			"azuredevops_build_folder":                           build.ResourceBuildFolder(),
 			"azuredevops_project":                                core.ResourceProject(),
 			"azuredevops_project_features":                       core.ResourceProjectFeatures(),
 			"azuredevops_project_pipeline_settings":              core.ResourceProjectPipelineSettings(),
+			"azuredevops_project_retention_settings":             core.ResourceProjectRetentionSettings(),
 			"azuredevops_variable_group":                         taskagent.ResourceVariableGroup(),
+			"azuredevops_task_group":                             taskagent.ResourceTaskGroup(),
+			"azuredevops_secure_file":                            taskagent.ResourceSecureFile(),
+			"azuredevops_deployment_group":                       taskagent.ResourceDeploymentGroup(),
+			"azuredevops_deployment_group_target_tags":           taskagent.ResourceDeploymentGroupTargetTags(),
 			"azuredevops_repository_policy_author_email_pattern": repository.ResourceRepositoryPolicyAuthorEmailPatterns(),
 			"azuredevops_repository_policy_file_path_pattern":    repository.ResourceRepositoryFilePathPatterns(),
 			"azuredevops_repository_policy_case_enforcement":     repository.ResourceRepositoryEnforceConsistentCase(),
@@ -94,6 +103,8 @@ func Provider() *schema.Provider {
 			"azuredevops_git_repository":                         git.ResourceGitRepository(),
 			"azuredevops_git_repository_branch":                  git.ResourceGitRepositoryBranch(),
 			"azuredevops_git_repository_file":                    git.ResourceGitRepositoryFile(),
+			"azuredevops_git_repository_tag":                     git.ResourceGitRepositoryTag(),
+			"azuredevops_git_pull_request":                       git.ResourceGitPullRequest(),
 			"azuredevops_user_entitlement":                       memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_group_entitlement":                      memberentitlementmanagement.ResourceGroupEntitlement(),
 			"azuredevops_group_membership":                       graph.ResourceGroupMembership(),
@@ -109,23 +120,30 @@ func Provider() *schema.Provider {
 			"azuredevops_build_definition_permissions":           permissions.ResourceBuildDefinitionPermissions(),
 			"azuredevops_build_folder_permissions":               permissions.ResourceBuildFolderPermissions(),
 			"azuredevops_variable_group_permissions":             permissions.ResourceVariableGroupPermissions(),
+			"azuredevops_secure_file_permissions":                permissions.ResourceSecureFilePermissions(),
 			"azuredevops_library_permissions":                    permissions.ResourceLibraryPermissions(),
 			"azuredevops_team":                                   core.ResourceTeam(),
 			"azuredevops_team_members":                           core.ResourceTeamMembers(),
 			"azuredevops_team_administrators":                    core.ResourceTeamAdministrators(),
 			"azuredevops_serviceendpoint_permissions":            permissions.ResourceServiceEndpointPermissions(),
 			"azuredevops_servicehook_permissions":                permissions.ResourceServiceHookPermissions(),
+			"azuredevops_servicehook_service_bus_work_items":     servicehook.ResourceServicehookServiceBusWorkItems(),
 			"azuredevops_tagging_permissions":                    permissions.ResourceTaggingPermissions(),
 			"azuredevops_environment":                            taskagent.ResourceEnvironment(),
 			"azuredevops_environment_resource_kubernetes":        taskagent.ResourceEnvironmentKubernetes(),
 			"azuredevops_wiki":                                   wiki.ResourceWiki(),
 			"azuredevops_workitem":                               workitemtracking.ResourceWorkItem(),
 			"azuredevops_servicehook_storage_queue_pipelines":    servicehook.ResourceServicehookStorageQueuePipelines(),
+			"azuredevops_servicehook_subscription":               servicehook.ResourceServicehookSubscription(),
+			"azuredevops_servicehook_webhook_git":                servicehook.ResourceServicehookWebhookGit(),
+			"azuredevops_notification_subscription":              notification.ResourceNotificationSubscription(),
 			"azuredevops_feed":                                   feed.ResourceFeed(),
 			"azuredevops_feed_permission":                        feed.ResourceFeedPermission(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"azuredevops_build_definition":           build.DataBuildDefinition(),
+			"azuredevops_build_definitions":          build.DataBuildDefinitions(),
+			"azuredevops_release_definition":         release.DataReleaseDefinition(),
 			"azuredevops_agent_pool":                 taskagent.DataAgentPool(),
 			"azuredevops_agent_pools":                taskagent.DataAgentPools(),
 			"azuredevops_agent_queue":                taskagent.DataAgentQueue(),
@@ -136,6 +154,8 @@ func Provider() *schema.Provider {
 			"azuredevops_projects":                   core.DataProjects(),
 			"azuredevops_git_repositories":           git.DataGitRepositories(),
 			"azuredevops_git_repository":             git.DataGitRepository(),
+			"azuredevops_git_repository_file":        git.DataGitRepositoryFile(),
+			"azuredevops_git_repository_refs":        git.DataGitRepositoryRefs(),
 			"azuredevops_users":                      graph.DataUsers(),
 			"azuredevops_area":                       workitemtracking.DataArea(),
 			"azuredevops_iteration":                  workitemtracking.DataIteration(),
@@ -146,12 +166,19 @@ func Provider() *schema.Provider {
 			"azuredevops_identity_group":             identity.DataIdentityGroup(),
 			"azuredevops_identity_user":              identity.DataIdentityUser(),
 			"azuredevops_variable_group":             taskagent.DataVariableGroup(),
+			"azuredevops_task_group":                 taskagent.DataTaskGroup(),
+			"azuredevops_deployment_group":           taskagent.DataDeploymentGroup(),
+			"azuredevops_deployment_group_targets":   taskagent.DataDeploymentGroupTargets(),
 			"azuredevops_securityrole_definitions":   securityroles.DataSecurityRoleDefinitions(),
 			"azuredevops_serviceendpoint_azurerm":    serviceendpoint.DataServiceEndpointAzureRM(),
 			"azuredevops_serviceendpoint_github":     serviceendpoint.DataServiceEndpointGithub(),
 			"azuredevops_serviceendpoint_npm":        serviceendpoint.DataResourceServiceEndpointNpm(),
 			"azuredevops_serviceendpoint_azurecr":    serviceendpoint.DataResourceServiceEndpointAzureCR(),
 			"azuredevops_serviceendpoint_sonarcloud": serviceendpoint.DataResourceServiceEndpointSonarCloud(),
+			"azuredevops_servicehook_publishers":     servicehook.DataServicehookPublishers(),
+			"azuredevops_servicehook_consumers":      servicehook.DataServicehookConsumers(),
+			"azuredevops_servicehook_subscriptions":  servicehook.DataServicehookSubscriptions(),
+			"azuredevops_servicehook_notifications":  servicehook.DataServicehookNotifications(),
 			"azuredevops_feed":                       feed.DataFeed(),
 		},
 		Schema: map[string]*schema.Schema{
@@ -34,7 +34,9 @@ func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"azuredevops_resource_authorization",
 		"azuredevops_pipeline_authorization",
+		"azuredevops_pipeline_run",
 		"azuredevops_build_definition",
+		"azuredevops_release_definition",
 		"azuredevops_build_definition_permissions",
 		"azuredevops_branch_policy_build_validation",
 		"azuredevops_branch_policy_min_reviewers",
@@ -46,6 +48,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_project",
 		"azuredevops_project_features",
 		"azuredevops_project_pipeline_settings",
+		"azuredevops_project_retention_settings",
 		"azuredevops_check_approval",
 		"azuredevops_check_exclusive_lock",
 		"azuredevops_check_branch_control",
@@ -84,6 +87,10 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_externaltfs",
 		"azuredevops_serviceendpoint_nuget",
 		"azuredevops_variable_group",
+		"azuredevops_task_group",
+		"azuredevops_secure_file",
+		"azuredevops_deployment_group",
+		"azuredevops_deployment_group_target_tags",
 		"azuredevops_repository_policy_author_email_pattern",
 		"azuredevops_repository_policy_case_enforcement",
 		"azuredevops_repository_policy_file_path_pattern",
@@ -94,6 +101,8 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_branch",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_tag",
+		"azuredevops_git_pull_request",
 		"azuredevops_user_entitlement",
 		"azuredevops_group_entitlement",
 		"azuredevops_group_membership",
@@ -112,9 +121,14 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_team_administrators",
 		"azuredevops_serviceendpoint_permissions",
 		"azuredevops_servicehook_permissions",
+		"azuredevops_servicehook_service_bus_work_items",
 		"azuredevops_servicehook_storage_queue_pipelines",
+		"azuredevops_servicehook_subscription",
+		"azuredevops_servicehook_webhook_git",
+		"azuredevops_notification_subscription",
 		"azuredevops_tagging_permissions",
 		"azuredevops_variable_group_permissions",
+		"azuredevops_secure_file_permissions",
 		"azuredevops_library_permissions",
 		"azuredevops_environment",
 		"azuredevops_environment_resource_kubernetes",
@@ -137,12 +151,16 @@ func TestProvider_HasChildResources(t *testing.T) {
 func TestProvider_HasChildDataSources(t *testing.T) {
 	expectedDataSources := []string{
 		"azuredevops_build_definition",
+		"azuredevops_build_definitions",
+		"azuredevops_release_definition",
 		"azuredevops_client_config",
 		"azuredevops_group",
 		"azuredevops_project",
 		"azuredevops_projects",
 		"azuredevops_git_repositories",
 		"azuredevops_git_repository",
+		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_refs",
 		"azuredevops_users",
 		"azuredevops_agent_pool",
 		"azuredevops_agent_pools",
@@ -157,11 +175,18 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_identity_group",
 		"azuredevops_identity_groups",
 		"azuredevops_variable_group",
+		"azuredevops_task_group",
+		"azuredevops_deployment_group",
+		"azuredevops_deployment_group_targets",
 		"azuredevops_securityrole_definitions",
 		"azuredevops_serviceendpoint_azurerm",
 		"azuredevops_serviceendpoint_github",
 		"azuredevops_serviceendpoint_npm",
 		"azuredevops_serviceendpoint_sonarcloud",
+		"azuredevops_servicehook_publishers",
+		"azuredevops_servicehook_consumers",
+		"azuredevops_servicehook_subscriptions",
+		"azuredevops_servicehook_notifications",
 		"azuredevops_serviceendpoint_azurecr",
 		"azuredevops_feed",
 	}
@@ -0,0 +1,104 @@
+// This is a partial copy of github.com/microsoft/azure-devops-go-api/azuredevops/build/client.go
+// The existing version of GetDefinitions returns build definition references, which do not contain the repository and
+// the process of the definitions even if all properties are included
+
+// This file cannot be under "internal", because azdosdkmocks/buildextras_sdk_mock.go depends on it.
+
+package buildextras
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+)
+
+type Client interface {
+	// Gets a list of definitions including all their properties.
+	GetDefinitions(context.Context, GetDefinitionsArgs) (*GetDefinitionsResponseValue, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+var definitionsLocationId, _ = uuid.Parse("dbeaf647-6167-421a-bda9-c9327b25e2e6")
+
+// Gets a list of definitions including all their properties.
+func (client *ClientImpl) GetDefinitions(ctx context.Context, args GetDefinitionsArgs) (*GetDefinitionsResponseValue, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	queryParams.Add("includeAllProperties", "true")
+	if args.Name != nil {
+		queryParams.Add("name", *args.Name)
+	}
+	if args.RepositoryId != nil {
+		queryParams.Add("repositoryId", *args.RepositoryId)
+	}
+	if args.RepositoryType != nil {
+		queryParams.Add("repositoryType", *args.RepositoryType)
+	}
+	if args.Top != nil {
+		queryParams.Add("$top", strconv.Itoa(*args.Top))
+	}
+	if args.ContinuationToken != nil {
+		queryParams.Add("continuationToken", *args.ContinuationToken)
+	}
+	if args.Path != nil {
+		queryParams.Add("path", *args.Path)
+	}
+	if args.YamlFilename != nil {
+		queryParams.Add("yamlFilename", *args.YamlFilename)
+	}
+	resp, err := client.Client.Send(ctx, http.MethodGet, definitionsLocationId, "7.1-preview.7", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue GetDefinitionsResponseValue
+	responseValue.ContinuationToken = resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue.Value)
+	return &responseValue, err
+}
+
+// Arguments for the GetDefinitions function
+type GetDefinitionsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (optional) If specified, filters to definitions whose names match this pattern.
+	Name *string
+	// (optional) A repository ID. If specified, filters to definitions that use this repository.
+	RepositoryId *string
+	// (optional) If specified, filters to definitions that have a repository of this type.
+	RepositoryType *string
+	// (optional) The maximum number of definitions to return.
+	Top *int
+	// (optional) A continuation token, returned by a previous call to this method, that can be used to return the next set of definitions.
+	ContinuationToken *string
+	// (optional) If specified, filters to definitions under this folder.
+	Path *string
+	// (optional) If specified, filters to YAML definitions that match the given filename.
+	YamlFilename *string
+}
+
+// Return type for the GetDefinitions function
+type GetDefinitionsResponseValue struct {
+	Value             []build.BuildDefinition
+	ContinuationToken string
+}
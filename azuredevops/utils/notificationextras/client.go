@@ -0,0 +1,140 @@
+// This is a partial copy of github.com/microsoft/azure-devops-go-api/azuredevops/notification/client.go
+// The existing version does not send the filter criteria and the channel address of subscriptions
+
+// This file cannot be under "internal", because azdosdkmocks/notificationextras_sdk_mock.go depends on it.
+
+package notificationextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+type Client interface {
+	// [Preview API] Create a new subscription.
+	CreateSubscription(context.Context, CreateSubscriptionArgs) (*NotificationSubscription, error)
+	// [Preview API] Delete a subscription.
+	DeleteSubscription(context.Context, DeleteSubscriptionArgs) error
+	// [Preview API] Get a notification subscription by its ID.
+	GetSubscription(context.Context, GetSubscriptionArgs) (*NotificationSubscription, error)
+	// [Preview API] Update an existing subscription. Depending on the type of subscription and permissions, the caller can update the description, filter settings, channel (delivery) settings and more.
+	UpdateSubscription(context.Context, UpdateSubscriptionArgs) (*NotificationSubscription, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+var subscriptionsLocationId, _ = uuid.Parse("70f911d6-abac-488c-85b3-a206bf57e165")
+
+// [Preview API] Create a new subscription.
+func (client *ClientImpl) CreateSubscription(ctx context.Context, args CreateSubscriptionArgs) (*NotificationSubscription, error) {
+	if args.CreateParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreateParameters"}
+	}
+	body, marshalErr := json.Marshal(*args.CreateParameters)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPost, subscriptionsLocationId, "7.1-preview.1", nil, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue NotificationSubscription
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the CreateSubscription function
+type CreateSubscriptionArgs struct {
+	// (required)
+	CreateParameters *NotificationSubscriptionCreateParameters
+}
+
+// [Preview API] Delete a subscription.
+func (client *ClientImpl) DeleteSubscription(ctx context.Context, args DeleteSubscriptionArgs) error {
+	routeValues := make(map[string]string)
+	if args.SubscriptionId == nil || *args.SubscriptionId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.SubscriptionId"}
+	}
+	routeValues["subscriptionId"] = *args.SubscriptionId
+
+	_, err := client.Client.Send(ctx, http.MethodDelete, subscriptionsLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteSubscription function
+type DeleteSubscriptionArgs struct {
+	// (required)
+	SubscriptionId *string
+}
+
+// [Preview API] Get a notification subscription by its ID.
+func (client *ClientImpl) GetSubscription(ctx context.Context, args GetSubscriptionArgs) (*NotificationSubscription, error) {
+	routeValues := make(map[string]string)
+	if args.SubscriptionId == nil || *args.SubscriptionId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.SubscriptionId"}
+	}
+	routeValues["subscriptionId"] = *args.SubscriptionId
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, subscriptionsLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue NotificationSubscription
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetSubscription function
+type GetSubscriptionArgs struct {
+	// (required)
+	SubscriptionId *string
+}
+
+// [Preview API] Update an existing subscription. Depending on the type of subscription and permissions, the caller can update the description, filter settings, channel (delivery) settings and more.
+func (client *ClientImpl) UpdateSubscription(ctx context.Context, args UpdateSubscriptionArgs) (*NotificationSubscription, error) {
+	if args.UpdateParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.UpdateParameters"}
+	}
+	routeValues := make(map[string]string)
+	if args.SubscriptionId == nil || *args.SubscriptionId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.SubscriptionId"}
+	}
+	routeValues["subscriptionId"] = *args.SubscriptionId
+
+	body, marshalErr := json.Marshal(*args.UpdateParameters)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPatch, subscriptionsLocationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue NotificationSubscription
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateSubscription function
+type UpdateSubscriptionArgs struct {
+	// (required)
+	UpdateParameters *NotificationSubscriptionUpdateParameters
+	// (required)
+	SubscriptionId *string
+}
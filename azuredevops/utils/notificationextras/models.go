@@ -0,0 +1,94 @@
+// This is a partial copy of github.com/microsoft/azure-devops-go-api/azuredevops/notification/models.go
+// The existing version of ISubscriptionFilter does not contain the "Criteria" property of expression filters and the
+// existing version of ISubscriptionChannel does not contain the "Address" and "UseCustomAddress" properties of email channels
+
+// This file cannot be under "internal", because azdosdkmocks/notificationextras_sdk_mock.go depends on it.
+
+package notificationextras
+
+import (
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/notification"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+)
+
+// A subscription defines criteria for matching events and how the subscription's subscriber should be notified about those events.
+type NotificationSubscription struct {
+	// Admin-managed settings for the subscription. Only applies to subscriptions where the subscriber is a group.
+	AdminSettings *notification.SubscriptionAdminSettings `json:"adminSettings,omitempty"`
+	// Channel for delivering notifications triggered by the subscription.
+	Channel *SubscriptionChannel `json:"channel,omitempty"`
+	// Description of the subscription. Typically describes filter criteria which helps identity the subscription.
+	Description *string `json:"description,omitempty"`
+	// Matching criteria for the subscription.
+	Filter *SubscriptionFilter `json:"filter,omitempty"`
+	// Read-only indicators that further describe the subscription.
+	Flags *notification.SubscriptionFlags `json:"flags,omitempty"`
+	// Subscription identifier.
+	Id *string `json:"id,omitempty"`
+	// User that last modified (or created) the subscription.
+	LastModifiedBy *webapi.IdentityRef `json:"lastModifiedBy,omitempty"`
+	// Date when the subscription was last modified. If the subscription has not been updated since it was created, this value will indicate when the subscription was created.
+	ModifiedDate *azuredevops.Time `json:"modifiedDate,omitempty"`
+	// The container in which events must be published from in order to be matched by the subscription. If empty, the scope is the current host (typically an account or project collection).
+	Scope *notification.SubscriptionScope `json:"scope,omitempty"`
+	// Status of the subscription. Typically indicates whether the subscription is enabled or not.
+	Status *notification.SubscriptionStatus `json:"status,omitempty"`
+	// Message that provides more details about the status of the subscription.
+	StatusMessage *string `json:"statusMessage,omitempty"`
+	// User or group that will receive notifications for events matching the subscription's filter criteria.
+	Subscriber *webapi.IdentityRef `json:"subscriber,omitempty"`
+	// REST API URL of the subscription.
+	Url *string `json:"url,omitempty"`
+	// User-managed settings for the subscription. Only applies when the subscriber is a group. Typically used to indicate whether the calling user is opted in or out of a group subscription.
+	UserSettings *notification.SubscriptionUserSettings `json:"userSettings,omitempty"`
+}
+
+// Parameters for creating a new subscription.
+type NotificationSubscriptionCreateParameters struct {
+	// Channel for delivering notifications triggered by the new subscription.
+	Channel *SubscriptionChannel `json:"channel,omitempty"`
+	// Brief description for the new subscription. Typically describes filter criteria which helps identity the subscription.
+	Description *string `json:"description,omitempty"`
+	// Matching criteria for the new subscription.
+	Filter *SubscriptionFilter `json:"filter,omitempty"`
+	// The container in which events must be published from in order to be matched by the new subscription. If not specified, defaults to the current host (typically an account or project collection).
+	Scope *notification.SubscriptionScope `json:"scope,omitempty"`
+	// User or group that will receive notifications for events matching the subscription's filter criteria. If not specified, defaults to the calling user.
+	Subscriber *webapi.IdentityRef `json:"subscriber,omitempty"`
+}
+
+// Parameters for updating an existing subscription.
+type NotificationSubscriptionUpdateParameters struct {
+	// Admin-managed settings for the subscription. Only applies to subscriptions where the subscriber is a group.
+	AdminSettings *notification.SubscriptionAdminSettings `json:"adminSettings,omitempty"`
+	// Channel for delivering notifications triggered by the subscription.
+	Channel *SubscriptionChannel `json:"channel,omitempty"`
+	// Updated description. Typically describes filter criteria which helps identity the subscription.
+	Description *string `json:"description,omitempty"`
+	// Matching criteria for the subscription.
+	Filter *SubscriptionFilter `json:"filter,omitempty"`
+	// The container in which events must be published from in order to be matched by the new subscription. If not specified, defaults to the current host (typically the current account or project collection).
+	Scope *notification.SubscriptionScope `json:"scope,omitempty"`
+	// Updated status for the subscription. Typically used to enable or disable a subscription.
+	Status *notification.SubscriptionStatus `json:"status,omitempty"`
+	// Optional message that provides more details about the updated status.
+	StatusMessage *string `json:"statusMessage,omitempty"`
+}
+
+// Channel for delivering notifications, e.g. `EmailHtml`, `EmailPlaintext`, `User` or `Group`.
+type SubscriptionChannel struct {
+	// Email address of the email channels.
+	Address *string `json:"address,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	// Whether the email address overrides the preferred email address of the subscriber.
+	UseCustomAddress *bool `json:"useCustomAddress,omitempty"`
+}
+
+// Matching criteria of a subscription, e.g. an `Expression` filter.
+type SubscriptionFilter struct {
+	// Criteria of the expression filters.
+	Criteria  *notification.ExpressionFilterModel `json:"criteria,omitempty"`
+	EventType *string                             `json:"eventType,omitempty"`
+	Type      *string                             `json:"type,omitempty"`
+}
@@ -0,0 +1,97 @@
+// This is a partial extension of github.com/microsoft/azure-devops-go-api/azuredevops/release/client.go
+// The existing version does not contain the operations to get and update the release settings of a project
+
+// This file cannot be under "internal", because azdosdkmocks/releaseextras_sdk_mock.go depends on it.
+
+package releaseextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+)
+
+type Client interface {
+	// [Preview API] Gets the release settings
+	GetReleaseSettings(context.Context, GetReleaseSettingsArgs) (*release.ReleaseSettings, error)
+	// [Preview API] Updates the release settings
+	UpdateReleaseSettings(context.Context, UpdateReleaseSettingsArgs) (*release.ReleaseSettings, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, release.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+var releaseSettingsLocationId, _ = uuid.Parse("c63c3718-7cfd-41e0-b89b-81c1ca143437")
+
+// [Preview API] Gets the release settings
+func (client *ClientImpl) GetReleaseSettings(ctx context.Context, args GetReleaseSettingsArgs) (*release.ReleaseSettings, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, releaseSettingsLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue release.ReleaseSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetReleaseSettings function
+type GetReleaseSettingsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+}
+
+// [Preview API] Updates the release settings
+func (client *ClientImpl) UpdateReleaseSettings(ctx context.Context, args UpdateReleaseSettingsArgs) (*release.ReleaseSettings, error) {
+	if args.ReleaseSettings == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.ReleaseSettings"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	body, marshalErr := json.Marshal(*args.ReleaseSettings)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPut, releaseSettingsLocationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue release.ReleaseSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateReleaseSettings function
+type UpdateReleaseSettingsArgs struct {
+	// (required) The release settings to apply
+	ReleaseSettings *release.ReleaseSettings
+	// (required) Project ID or project name
+	Project *string
+}
@@ -0,0 +1,271 @@
+// This is a partial copy of github.com/microsoft/azure-devops-go-api/azuredevops/taskagent/client.go
+// The existing version does not contain the operations to publish drafts and previews of task groups and the
+// operations to manage secure files
+
+// This file cannot be under "internal", because azdosdkmocks/taskagentextras_sdk_mock.go depends on it.
+
+package taskagentextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+)
+
+type Client interface {
+	// [Preview API] Publish a draft task group as a new major version of its parent task group.
+	PublishTaskGroup(context.Context, PublishTaskGroupArgs) (*[]taskagent.TaskGroup, error)
+	// [Preview API] Publish a preview version of a task group.
+	PublishPreviewTaskGroup(context.Context, PublishPreviewTaskGroupArgs) (*[]taskagent.TaskGroup, error)
+	// [Preview API] Upload a secure file, include the file stream in the request body
+	UploadSecureFile(context.Context, UploadSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Get a secure file
+	GetSecureFile(context.Context, GetSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Update the name or properties of an existing secure file
+	UpdateSecureFile(context.Context, UpdateSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Delete a secure file
+	DeleteSecureFile(context.Context, DeleteSecureFileArgs) error
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+var taskGroupsLocationId, _ = uuid.Parse("6c08ffbf-dbf1-4f9a-94e5-a1cbd47005e7")
+var secureFilesLocationId, _ = uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+
+// [Preview API] Publish a draft task group as a new major version of its parent task group.
+func (client *ClientImpl) PublishTaskGroup(ctx context.Context, args PublishTaskGroupArgs) (*[]taskagent.TaskGroup, error) {
+	if args.TaskGroupMetadata == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroupMetadata"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.ParentTaskGroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.ParentTaskGroupId"}
+	}
+	queryParams.Add("parentTaskGroupId", (*args.ParentTaskGroupId).String())
+
+	body, marshalErr := json.Marshal(*args.TaskGroupMetadata)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPut, taskGroupsLocationId, "7.1-preview.1", routeValues, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.TaskGroup
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the PublishTaskGroup function
+type PublishTaskGroupArgs struct {
+	// (required) Metadata of the draft task group to publish.
+	TaskGroupMetadata *taskagent.PublishTaskGroupMetadata
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the parent task group.
+	ParentTaskGroupId *uuid.UUID
+}
+
+// [Preview API] Publish a preview version of a task group.
+func (client *ClientImpl) PublishPreviewTaskGroup(ctx context.Context, args PublishPreviewTaskGroupArgs) (*[]taskagent.TaskGroup, error) {
+	if args.TaskGroup == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroup"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.TaskGroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroupId"}
+	}
+	routeValues["taskGroupId"] = (*args.TaskGroupId).String()
+
+	queryParams := url.Values{}
+	if args.DisablePriorVersions != nil {
+		queryParams.Add("disablePriorVersions", strconv.FormatBool(*args.DisablePriorVersions))
+	}
+
+	body, marshalErr := json.Marshal(*args.TaskGroup)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPatch, taskGroupsLocationId, "7.1-preview.1", routeValues, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.TaskGroup
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the PublishPreviewTaskGroup function
+type PublishPreviewTaskGroupArgs struct {
+	// (required) The preview version of the task group.
+	TaskGroup *taskagent.TaskGroup
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the task group.
+	TaskGroupId *uuid.UUID
+	// (optional) Whether the prior versions of the task group are disabled.
+	DisablePriorVersions *bool
+}
+
+// [Preview API] Upload a secure file, include the file stream in the request body
+func (client *ClientImpl) UploadSecureFile(ctx context.Context, args UploadSecureFileArgs) (*taskagent.SecureFile, error) {
+	if args.UploadStream == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.UploadStream"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.Name == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Name"}
+	}
+	queryParams.Add("name", *args.Name)
+	if args.AuthorizePipeline != nil {
+		queryParams.Add("authorizePipeline", strconv.FormatBool(*args.AuthorizePipeline))
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPost, secureFilesLocationId, "7.1-preview.1", routeValues, queryParams, args.UploadStream, "application/octet-stream", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UploadSecureFile function
+type UploadSecureFileArgs struct {
+	// (required) Stream to upload
+	UploadStream io.Reader
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name of the file to upload
+	Name *string
+	// (optional) If authorizePipeline is true, then the secure file is authorized for use by all pipelines in the project.
+	AuthorizePipeline *bool
+}
+
+// [Preview API] Get a secure file
+func (client *ClientImpl) GetSecureFile(ctx context.Context, args GetSecureFileArgs) (*taskagent.SecureFile, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, secureFilesLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetSecureFile function
+type GetSecureFileArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Update the name or properties of an existing secure file
+func (client *ClientImpl) UpdateSecureFile(ctx context.Context, args UpdateSecureFileArgs) (*taskagent.SecureFile, error) {
+	if args.SecureFile == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFile"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	body, marshalErr := json.Marshal(*args.SecureFile)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPatch, secureFilesLocationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateSecureFile function
+type UpdateSecureFileArgs struct {
+	// (required) The secure file with updated name and/or properties
+	SecureFile *taskagent.SecureFile
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Delete a secure file
+func (client *ClientImpl) DeleteSecureFile(ctx context.Context, args DeleteSecureFileArgs) error {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	_, err := client.Client.Send(ctx, http.MethodDelete, secureFilesLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteSecureFile function
+type DeleteSecureFileArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}